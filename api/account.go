@@ -1,35 +1,29 @@
 package api
 
 import (
+	"context"
+	"fmt"
 	"strconv"
-
-	"github.com/nexaa-cloud/nexaa-cli/config"
-	"github.com/nexaa-cloud/nexaa-cli/graphql"
 )
 
-func GetAccountId() (int, error) {
-	client := graphql.NewClient(config.GRAPHQL_URL, config.AccessToken)
-
-	var accountQuery struct {
-		Account struct {
-			Customer struct {
-				Id string
-			}
-		}
-	}
-
-	params := map[string]graphql.Parameter{}
-
-	query := client.BuildQuery(&accountQuery, params)
-
-	err := client.Query(query)
+// GetAccountId returns the id of the customer the logged in account belongs to.
+func (client *Client) GetAccountId(ctx context.Context) (int, error) {
+	accountResponse, err := account(ctx, *client.client)
 	if err != nil {
 		return 0, err
 	}
 
-	if id, err := strconv.Atoi(accountQuery.Account.Customer.Id); err == nil {
-		return id, nil
+	id, err := strconv.Atoi(accountResponse.Account.Customer.Id)
+	if err != nil {
+		return 0, fmt.Errorf("invalid customer id %q: %w", accountResponse.Account.Customer.Id, err)
 	}
 
-	return 0, err
+	return id, nil
+}
+
+// GetAccountId returns the customer id of the account logged in with the CLI.
+//
+// Deprecated: use Client.GetAccountId.
+func GetAccountId() (int, error) {
+	return NewClient().GetAccountId(context.Background())
 }
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+	"strconv"
+)
+
+// AuditLogs returns the audit log entries of the customer, filtered on event types and model names when set.
+func (client *Client) AuditLogs(ctx context.Context, customerId int, filter *AuditLogFilterInput) ([]AuditLogResult, error) {
+	auditLogsResponse, err := auditLogs(ctx, *client.client, strconv.Itoa(customerId), filter)
+	if err != nil {
+		return []AuditLogResult{}, err
+	}
+
+	result := make([]AuditLogResult, 0, len(auditLogsResponse.AuditLogs))
+	for _, entry := range auditLogsResponse.AuditLogs {
+		if entry != nil {
+			result = append(result, *entry)
+		}
+	}
+
+	return result, nil
+}
@@ -1,34 +1,139 @@
 package api
 
 import (
+	"context"
+	"net/http"
+
 	"github.com/Khan/genqlient/graphql"
 	"github.com/nexaa-cloud/nexaa-cli/config"
-	"net/http"
 )
 
+// DefaultUserAgent is sent with every request unless WithUserAgent sets another one.
+const DefaultUserAgent = "nexaa-cli"
+
+// TokenSource returns the access token sent with a request, it is called for every request so it can
+// refresh the token when it expires.
+type TokenSource interface {
+	Token() (string, error)
+}
+
+// StaticToken is a TokenSource that always returns the same token.
+type StaticToken string
+
+func (token StaticToken) Token() (string, error) {
+	return string(token), nil
+}
+
+// configToken returns the token of the logged in user, read from config when the request is made.
+type configToken struct{}
+
+func (configToken) Token() (string, error) {
+	return config.AccessToken, nil
+}
+
 type authedTransport struct {
-	key     string
-	wrapped http.RoundTripper
+	tokens    TokenSource
+	userAgent string
+	wrapped   http.RoundTripper
 }
 
 func (t *authedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	req.Header.Set("Authorization", "Bearer "+t.key)
+	token, err := t.tokens.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	// A RoundTripper must not modify the request it was given.
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	if t.userAgent != "" {
+		req.Header.Set("User-Agent", t.userAgent)
+	}
 	return t.wrapped.RoundTrip(req)
 }
 
+// validatingClient rejects requests with variables that do not match the schema before they are sent.
+type validatingClient struct {
+	wrapped graphql.Client
+}
+
+func (c *validatingClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	if err := ValidateRequest(req.Query, req.Variables); err != nil {
+		return err
+	}
+	return c.wrapped.MakeRequest(ctx, req, resp)
+}
+
+type clientOptions struct {
+	endpoint   string
+	tokens     TokenSource
+	httpClient *http.Client
+	userAgent  string
+}
+
+// Option configures a Client created by NewClient.
+type Option func(*clientOptions)
+
+// WithEndpoint sets the URL of the GraphQL API.
+func WithEndpoint(endpoint string) Option {
+	return func(options *clientOptions) {
+		options.endpoint = endpoint
+	}
+}
+
+// WithTokenSource sets where the access token sent with each request comes from.
+func WithTokenSource(tokens TokenSource) Option {
+	return func(options *clientOptions) {
+		options.tokens = tokens
+	}
+}
+
+// WithHTTPClient sets the HTTP client requests are sent with. Its transport is wrapped to add the
+// access token and user agent, the client itself is not modified. A nil client keeps the default.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(options *clientOptions) {
+		if httpClient != nil {
+			options.httpClient = httpClient
+		}
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with each request.
+func WithUserAgent(userAgent string) Option {
+	return func(options *clientOptions) {
+		options.userAgent = userAgent
+	}
+}
+
 type Client struct {
 	client *graphql.Client
 }
 
-func NewClient() *Client {
-	httpClient := http.Client{
-		Transport: &authedTransport{
-			key:     config.AccessToken,
-			wrapped: http.DefaultTransport,
-		},
+// NewClient creates a client for the API. Without options it uses the endpoint and the login stored in
+// config, the way the CLI does.
+func NewClient(opts ...Option) *Client {
+	options := clientOptions{
+		endpoint:   config.GRAPHQL_URL,
+		tokens:     configToken{},
+		httpClient: http.DefaultClient,
+		userAgent:  DefaultUserAgent,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	httpClient := *options.httpClient
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	httpClient.Transport = &authedTransport{
+		tokens:    options.tokens,
+		userAgent: options.userAgent,
+		wrapped:   transport,
 	}
 
-	client := graphql.NewClient(config.GRAPHQL_URL, &httpClient)
+	var client graphql.Client = &validatingClient{wrapped: graphql.NewClient(options.endpoint, &httpClient)}
 
 	return &Client{client: &client}
 }
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClientOptions(t *testing.T) {
+	var authorization, userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		userAgent = r.Header.Get("User-Agent")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"namespaces": [{"name": "production"}]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithEndpoint(server.URL),
+		WithTokenSource(StaticToken("secret")),
+		WithHTTPClient(server.Client()),
+		WithUserAgent("tooling/1.0"),
+	)
+
+	namespaces, err := client.NamespacesList(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "production", namespaces[0].Name)
+	assert.Equal(t, "Bearer secret", authorization)
+	assert.Equal(t, "tooling/1.0", userAgent)
+}
+
+func TestNewClientNilHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"namespaces": []}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithEndpoint(server.URL), WithTokenSource(StaticToken("secret")), WithHTTPClient(nil))
+
+	_, err := client.NamespacesList(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestNewClientCancelledContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request should be sent with a cancelled context")
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := NewClient(WithEndpoint(server.URL), WithTokenSource(StaticToken("secret"))).NamespacesList(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestGetAccountId(t *testing.T) {
+	id := "42"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"account": {"customer": {"id": "` + id + `"}}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithEndpoint(server.URL), WithTokenSource(StaticToken("secret")))
+
+	customerId, err := client.GetAccountId(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 42, customerId)
+
+	id = "unknown"
+	_, err = client.GetAccountId(context.Background())
+	assert.Error(t, err, "a customer id that is not a number is an error")
+}
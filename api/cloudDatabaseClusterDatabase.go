@@ -4,10 +4,16 @@ import (
 	"context"
 )
 
-func (client *Client) CloudDatabaseClusterDatabaseList(input CloudDatabaseClusterResourceInput) (getCloudDatabaseClusterDatabasesCloudDatabaseCluster, error) {
-	resp, err := getCloudDatabaseClusterDatabases(context.Background(), *client.client, input)
+func (client *Client) CloudDatabaseClusterDatabaseList(ctx context.Context, input CloudDatabaseClusterResourceInput) ([]CloudDatabaseClusterDatabaseResult, error) {
+	resp, err := getCloudDatabaseClusterDatabases(ctx, *client.client, input)
 	if err != nil {
-		return getCloudDatabaseClusterDatabasesCloudDatabaseCluster{}, err
+		return []CloudDatabaseClusterDatabaseResult{}, err
 	}
-	return resp.GetCloudDatabaseCluster(), nil
+
+	var databases []CloudDatabaseClusterDatabaseResult
+	cluster := resp.GetCloudDatabaseCluster()
+	for _, database := range cluster.GetDatabases() {
+		databases = append(databases, database.CloudDatabaseClusterDatabaseResult)
+	}
+	return databases, nil
 }
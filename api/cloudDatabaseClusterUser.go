@@ -5,8 +5,8 @@ import (
 	"errors"
 )
 
-func (client *Client) CloudDatabaseClusterUserList(input CloudDatabaseClusterResourceInput) ([]CloudDatabaseClusterUserResult, error) {
-	resp, err := getCloudDatabaseClusterUsers(context.Background(), *client.client, input)
+func (client *Client) CloudDatabaseClusterUserList(ctx context.Context, input CloudDatabaseClusterResourceInput) ([]CloudDatabaseClusterUserResult, error) {
+	resp, err := getCloudDatabaseClusterUsers(ctx, *client.client, input)
 	if err != nil {
 		return []CloudDatabaseClusterUserResult{}, err
 	}
@@ -27,8 +27,8 @@ func (client *Client) CloudDatabaseClusterUserList(input CloudDatabaseClusterRes
 	return users, nil
 }
 
-func (client *Client) CloudDatabaseClusterUserGet(input CloudDatabaseClusterResourceInput, name string) (CloudDatabaseClusterUserResult, error) {
-	resp, err := client.CloudDatabaseClusterUserList(input)
+func (client *Client) CloudDatabaseClusterUserGet(ctx context.Context, input CloudDatabaseClusterResourceInput, name string) (CloudDatabaseClusterUserResult, error) {
+	resp, err := client.CloudDatabaseClusterUserList(ctx, input)
 	if err != nil {
 		return CloudDatabaseClusterUserResult{}, err
 	}
@@ -47,8 +47,8 @@ func (client *Client) CloudDatabaseClusterUserGet(input CloudDatabaseClusterReso
 	return result, nil
 }
 
-func (client *Client) CloudDatabaseClusterUserModify(input CloudDatabaseClusterUserModifyInput) (CloudDatabaseClusterUserResult, error) {
-	resp, err := modifyCloudDatabaseClusterUser(context.Background(), *client.client, input)
+func (client *Client) CloudDatabaseClusterUserModify(ctx context.Context, input CloudDatabaseClusterUserModifyInput) (CloudDatabaseClusterUserResult, error) {
+	resp, err := modifyCloudDatabaseClusterUser(ctx, *client.client, input)
 	if err != nil {
 		return CloudDatabaseClusterUserResult{}, err
 	}
@@ -65,8 +65,8 @@ func (client *Client) CloudDatabaseClusterUserModify(input CloudDatabaseClusterU
 	return user, nil
 }
 
-func (client *Client) CloudDatabaseClusterUserCreate(input CloudDatabaseClusterUserCreateInput) (CloudDatabaseClusterUserResult, error) {
-	resp, err := createCloudDatabaseClusterUser(context.Background(), *client.client, input)
+func (client *Client) CloudDatabaseClusterUserCreate(ctx context.Context, input CloudDatabaseClusterUserCreateInput) (CloudDatabaseClusterUserResult, error) {
+	resp, err := createCloudDatabaseClusterUser(ctx, *client.client, input)
 	if err != nil {
 		return CloudDatabaseClusterUserResult{}, err
 	}
@@ -83,8 +83,8 @@ func (client *Client) CloudDatabaseClusterUserCreate(input CloudDatabaseClusterU
 	return user, nil
 }
 
-func (client *Client) CloudDatabaseClusterUserDelete(input CloudDatabaseClusterUserResourceInput) (bool, error) {
-	resp, err := deleteCloudDatabaseClusterUser(context.Background(), *client.client, input)
+func (client *Client) CloudDatabaseClusterUserDelete(ctx context.Context, input CloudDatabaseClusterUserResourceInput) (bool, error) {
+	resp, err := deleteCloudDatabaseClusterUser(ctx, *client.client, input)
 	if err != nil {
 		return false, err
 	}
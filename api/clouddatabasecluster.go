@@ -4,24 +4,24 @@ import (
 	"context"
 )
 
-func (client *Client) CloudDatabaseClusterCreate(input CloudDatabaseClusterCreateInput) (CloudDatabaseClusterResult, error) {
-	resp, err := cloudDatabaseClusterCreate(context.Background(), *client.client, input)
+func (client *Client) CloudDatabaseClusterCreate(ctx context.Context, input CloudDatabaseClusterCreateInput) (CloudDatabaseClusterResult, error) {
+	resp, err := cloudDatabaseClusterCreate(ctx, *client.client, input)
 	if err != nil {
 		return CloudDatabaseClusterResult{}, err
 	}
 	return resp.GetCloudDatabaseClusterCreate(), nil
 }
 
-func (client *Client) CloudDatabaseClusterModify(input CloudDatabaseClusterModifyInput) (CloudDatabaseClusterResult, error) {
-	resp, err := cloudDatabaseClusterModify(context.Background(), *client.client, input)
+func (client *Client) CloudDatabaseClusterModify(ctx context.Context, input CloudDatabaseClusterModifyInput) (CloudDatabaseClusterResult, error) {
+	resp, err := cloudDatabaseClusterModify(ctx, *client.client, input)
 	if err != nil {
 		return CloudDatabaseClusterResult{}, err
 	}
 	return resp.GetCloudDatabaseClusterModify(), nil
 }
 
-func (client *Client) CloudDatabaseClusterList() ([]CloudDatabaseClusterResult, error) {
-	resp, err := getCloudDatabaseClusters(context.Background(), *client.client)
+func (client *Client) CloudDatabaseClusterList(ctx context.Context) ([]CloudDatabaseClusterResult, error) {
+	resp, err := getCloudDatabaseClusters(ctx, *client.client)
 	if err != nil {
 		return []CloudDatabaseClusterResult{}, err
 	}
@@ -31,48 +31,56 @@ func (client *Client) CloudDatabaseClusterList() ([]CloudDatabaseClusterResult,
 	return result, nil
 }
 
-func (client *Client) CloudDatabaseClusterGet(input CloudDatabaseClusterResourceInput) (CloudDatabaseClusterResult, error) {
-	resp, err := getCloudDatabaseCluster(context.Background(), *client.client, input)
+func (client *Client) CloudDatabaseClusterGet(ctx context.Context, input CloudDatabaseClusterResourceInput) (CloudDatabaseClusterResult, error) {
+	resp, err := getCloudDatabaseCluster(ctx, *client.client, input)
 	if err != nil {
 		return CloudDatabaseClusterResult{}, err
 	}
 	return resp.GetCloudDatabaseCluster(), nil
 }
 
-func (client *Client) CloudDatabaseClusterDelete(input CloudDatabaseClusterResourceInput) (bool, error) {
-	resp, err := cloudDatabaseClusterDelete(context.Background(), *client.client, input)
+func (client *Client) CloudDatabaseClusterDelete(ctx context.Context, input CloudDatabaseClusterResourceInput) (bool, error) {
+	resp, err := cloudDatabaseClusterDelete(ctx, *client.client, input)
 	if err != nil {
 		return false, err
 	}
 	return resp.GetCloudDatabaseClusterDelete(), nil
 }
 
-func (client *Client) CloudDatabaseClusterDatabaseCreate(input CloudDatabaseClusterDatabaseCreateInput) (CloudDatabaseClusterDatabaseResult, error) {
-	resp, err := createCloudDatabaseClusterDatabase(context.Background(), *client.client, input)
+func (client *Client) CloudDatabaseClusterDatabaseCreate(ctx context.Context, input CloudDatabaseClusterDatabaseCreateInput) (CloudDatabaseClusterDatabaseResult, error) {
+	resp, err := createCloudDatabaseClusterDatabase(ctx, *client.client, input)
 	if err != nil {
 		return CloudDatabaseClusterDatabaseResult{}, err
 	}
 	return resp.GetCloudDatabaseClusterDatabaseCreate(), nil
 }
 
-func (client *Client) CloudDatabaseClusterDatabaseDelete(input CloudDatabaseClusterDatabaseResourceInput) (bool, error) {
-	resp, err := deleteCloudDatabaseClusterDatabase(context.Background(), *client.client, input)
+func (client *Client) CloudDatabaseClusterDatabaseDelete(ctx context.Context, input CloudDatabaseClusterDatabaseResourceInput) (bool, error) {
+	resp, err := deleteCloudDatabaseClusterDatabase(ctx, *client.client, input)
 	if err != nil {
 		return false, err
 	}
 	return resp.GetCloudDatabaseClusterDatabaseDelete(), nil
 }
 
-func (client *Client) CloudDatabaseClusterUserCredentials(cloudDatabase CloudDatabaseClusterResourceInput, userName string) (string, error) {
-	resp, err := getCloudDatabaseClusterUserCredentials(context.Background(), *client.client, cloudDatabase, userName)
+func (client *Client) CloudDatabaseClusterUserCredentials(ctx context.Context, cloudDatabase CloudDatabaseClusterResourceInput, userName string) (string, error) {
+	resp, err := getCloudDatabaseClusterUserCredentials(ctx, *client.client, cloudDatabase, userName)
 	if err != nil {
 		return "", err
 	}
 	return resp.GetCloudDatabaseClusterUserCredentials().Dsn, nil
 }
 
-func (client *Client) CloudDatabaseClusterListPlans() ([]CloudDatabaseClusterPlan, error) {
-	resp, err := clusterPlans(context.Background(), *client.client)
+func (client *Client) CloudDatabaseClusterUserCredentialsGet(ctx context.Context, cloudDatabase CloudDatabaseClusterResourceInput, userName string) (CloudDatabaseClusterUserResult, error) {
+	resp, err := getCloudDatabaseClusterUserCredentials(ctx, *client.client, cloudDatabase, userName)
+	if err != nil {
+		return CloudDatabaseClusterUserResult{}, err
+	}
+	return resp.GetCloudDatabaseClusterUserCredentials(), nil
+}
+
+func (client *Client) CloudDatabaseClusterListPlans(ctx context.Context) ([]CloudDatabaseClusterPlan, error) {
+	resp, err := clusterPlans(ctx, *client.client)
 	if err != nil {
 		return []CloudDatabaseClusterPlan{}, err
 	}
@@ -82,8 +90,8 @@ func (client *Client) CloudDatabaseClusterListPlans() ([]CloudDatabaseClusterPla
 	return result, nil
 }
 
-func (client *Client) CloudDatabaseClusterListSpecs() ([]CloudDatabaseClusterSpec, error) {
-	resp, err := clusterVersions(context.Background(), *client.client)
+func (client *Client) CloudDatabaseClusterListSpecs(ctx context.Context) ([]CloudDatabaseClusterSpec, error) {
+	resp, err := clusterVersions(ctx, *client.client)
 	if err != nil {
 		return []CloudDatabaseClusterSpec{}, err
 	}
@@ -92,3 +100,29 @@ func (client *Client) CloudDatabaseClusterListSpecs() ([]CloudDatabaseClusterSpe
 
 	return result, nil
 }
+
+// CloudDatabaseClusterFeature is an opt-in capability of a cluster. The API does not name features, they
+// are only told apart by their position in the list.
+type CloudDatabaseClusterFeature struct {
+	Enabled bool
+	Status  string
+}
+
+func (client *Client) CloudDatabaseClusterFeatures(ctx context.Context, input CloudDatabaseClusterResourceInput) ([]CloudDatabaseClusterFeature, error) {
+	resp, err := getCloudDatabaseClusterFeatures(ctx, *client.client, input)
+	if err != nil {
+		return []CloudDatabaseClusterFeature{}, err
+	}
+
+	cluster := resp.GetCloudDatabaseCluster()
+
+	result := make([]CloudDatabaseClusterFeature, 0, len(cluster.Features))
+	for _, feature := range cluster.GetFeatures() {
+		result = append(result, CloudDatabaseClusterFeature{
+			Enabled: feature.GetEnabled(),
+			Status:  feature.GetStatus(),
+		})
+	}
+
+	return result, nil
+}
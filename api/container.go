@@ -5,8 +5,8 @@ import (
 	"fmt"
 )
 
-func (client *Client) ListContainers(namespace string) ([]ContainerResult, error) {
-	containerResponse, err := containerList(context.Background(), *client.client, namespace)
+func (client *Client) ListContainers(ctx context.Context, namespace string) ([]ContainerResult, error) {
+	containerResponse, err := containerList(ctx, *client.client, namespace)
 	if err != nil {
 		return []ContainerResult{}, err
 	}
@@ -24,8 +24,8 @@ func (client *Client) ListContainers(namespace string) ([]ContainerResult, error
 	return result, nil
 }
 
-func (client *Client) ListContainerByName(namespace string, containerName string) (ContainerResult, error) {
-	container, err := containerByName(context.Background(), *client.client, namespace, containerName)
+func (client *Client) ListContainerByName(ctx context.Context, namespace string, containerName string) (ContainerResult, error) {
+	container, err := containerByName(ctx, *client.client, namespace, containerName)
 	if err != nil {
 		return ContainerResult{}, err
 	}
@@ -37,8 +37,8 @@ func (client *Client) ListContainerByName(namespace string, containerName string
 	return container.Container, err
 }
 
-func (client *Client) ContainerCreate(input ContainerCreateInput) (ContainerResult, error) {
-	containerCreateResponse, err := containerCreate(context.Background(), *client.client, input)
+func (client *Client) ContainerCreate(ctx context.Context, input ContainerCreateInput) (ContainerResult, error) {
+	containerCreateResponse, err := containerCreate(ctx, *client.client, input)
 	if err != nil {
 		return ContainerResult{}, err
 	}
@@ -46,8 +46,8 @@ func (client *Client) ContainerCreate(input ContainerCreateInput) (ContainerResu
 	return containerCreateResponse.GetContainerCreate(), nil
 }
 
-func (client *Client) ContainerModify(input ContainerModifyInput) (ContainerResult, error) {
-	containerModifyResponse, err := containerModify(context.Background(), *client.client, input)
+func (client *Client) ContainerModify(ctx context.Context, input ContainerModifyInput) (ContainerResult, error) {
+	containerModifyResponse, err := containerModify(ctx, *client.client, input)
 	if err != nil {
 		return ContainerResult{}, err
 	}
@@ -55,8 +55,8 @@ func (client *Client) ContainerModify(input ContainerModifyInput) (ContainerResu
 	return containerModifyResponse.GetContainerModify(), nil
 }
 
-func (client *Client) ContainerDelete(namespace string, containerName string) (bool, error) {
-	containerDeleteResponse, err := containerDelete(context.Background(), *client.client, namespace, containerName)
+func (client *Client) ContainerDelete(ctx context.Context, namespace string, containerName string) (bool, error) {
+	containerDeleteResponse, err := containerDelete(ctx, *client.client, namespace, containerName)
 	if err != nil {
 		return false, err
 	}
@@ -57,8 +57,8 @@ func toContainerJobResult(job ContainerJobResult) (ContainerJobResult, error) {
 	}, nil
 }
 
-func (client *Client) ContainerJobCreate(input ContainerJobCreateInput) (ContainerJobResult, error) {
-	containerJobCreateResponse, err := containerJobCreate(context.Background(), *client.client, input)
+func (client *Client) ContainerJobCreate(ctx context.Context, input ContainerJobCreateInput) (ContainerJobResult, error) {
+	containerJobCreateResponse, err := containerJobCreate(ctx, *client.client, input)
 	if err != nil {
 		return ContainerJobResult{}, err
 	}
@@ -66,8 +66,8 @@ func (client *Client) ContainerJobCreate(input ContainerJobCreateInput) (Contain
 	return containerJobCreateResponse.GetContainerJobCreate(), nil
 }
 
-func (client *Client) ContainerJobModify(input ContainerJobModifyInput) (ContainerJobResult, error) {
-	containerJobCreateResponse, err := containerJobModify(context.Background(), *client.client, input)
+func (client *Client) ContainerJobModify(ctx context.Context, input ContainerJobModifyInput) (ContainerJobResult, error) {
+	containerJobCreateResponse, err := containerJobModify(ctx, *client.client, input)
 	if err != nil {
 		return ContainerJobResult{}, err
 	}
@@ -75,9 +75,9 @@ func (client *Client) ContainerJobModify(input ContainerJobModifyInput) (Contain
 	return containerJobCreateResponse.GetContainerJobModify(), nil
 }
 
-func (client *Client) ContainerJobList(namespace string) ([]ContainerJobResult, error) {
+func (client *Client) ContainerJobList(ctx context.Context, namespace string) ([]ContainerJobResult, error) {
 
-	containerJobListResponse, err := containerJobList(context.Background(), *client.client, namespace)
+	containerJobListResponse, err := containerJobList(ctx, *client.client, namespace)
 
 	if err != nil {
 		return []ContainerJobResult{}, err
@@ -92,8 +92,8 @@ func (client *Client) ContainerJobList(namespace string) ([]ContainerJobResult,
 	return result, nil
 }
 
-func (client *Client) ContainerJobByName(namespace string, name string) (ContainerJobResult, error) {
-	apiResponse, err := containerJobByName(context.Background(), *client.client, namespace, name)
+func (client *Client) ContainerJobByName(ctx context.Context, namespace string, name string) (ContainerJobResult, error) {
+	apiResponse, err := containerJobByName(ctx, *client.client, namespace, name)
 
 	if err != nil {
 		return ContainerJobResult{}, err
@@ -108,8 +108,8 @@ func (client *Client) ContainerJobByName(namespace string, name string) (Contain
 	return containerJob, err
 }
 
-func (client *Client) ContainerJobDelete(namespace string, containerJobName string) (bool, error) {
-	containerJobDeleteResponse, err := containerJobDelete(context.Background(), *client.client, namespace, containerJobName)
+func (client *Client) ContainerJobDelete(ctx context.Context, namespace string, containerJobName string) (bool, error) {
+	containerJobDeleteResponse, err := containerJobDelete(ctx, *client.client, namespace, containerJobName)
 	if err != nil {
 		return false, err
 	}
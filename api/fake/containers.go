@@ -0,0 +1,301 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+// applyEnvironment applies changes to the environment variables. A variable in the ABSENT state is
+// removed, others are added or replace the variable with the same name.
+func applyEnvironment(current []api.EnvironmentVariableResult, changes []api.EnvironmentVariableInput) []api.EnvironmentVariableResult {
+	result := slices.Clone(current)
+	for _, change := range changes {
+		result = slices.DeleteFunc(result, func(env api.EnvironmentVariableResult) bool { return env.Name == change.Name })
+		if change.State == api.StateAbsent {
+			continue
+		}
+
+		value := change.Value
+		result = append(result, api.EnvironmentVariableResult{Name: change.Name, Value: &value, Secret: change.Secret})
+	}
+	return result
+}
+
+// applyMounts applies changes to the mounts of a container or job in the namespace. A mount in the
+// ABSENT state is removed, others are added and create their volume when AutoCreate is set.
+func (c *Client) applyMounts(namespace string, current []api.ContainerMounts, changes []api.MountInput) ([]api.ContainerMounts, error) {
+	result := slices.Clone(current)
+	for _, change := range changes {
+		result = slices.DeleteFunc(result, func(mount api.ContainerMounts) bool { return mount.Volume.Name == change.Volume.Name })
+		if change.State == api.StateAbsent {
+			continue
+		}
+
+		volume, ok := c.volumes[key{namespace, change.Volume.Name}]
+		if !ok {
+			if !change.Volume.AutoCreate || change.Volume.Size == nil {
+				return nil, fmt.Errorf("volume %q: %w", change.Volume.Name, ErrNotFound)
+			}
+			volume = api.VolumeResult{Name: change.Volume.Name, Size: float64(*change.Volume.Size), State: StateCreated}
+			c.volumes[key{namespace, volume.Name}] = volume
+		}
+		result = append(result, api.ContainerMounts{Path: change.Path, Volume: api.ContainerMountsVolume{Name: volume.Name, Size: volume.Size}})
+	}
+	return result, nil
+}
+
+func (c *Client) ListContainers(ctx context.Context, namespace string) ([]api.ContainerResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "ListContainers"); err != nil {
+		return []api.ContainerResult{}, err
+	}
+
+	return inNamespace(c.containers, namespace), nil
+}
+
+func (c *Client) ListContainerByName(ctx context.Context, namespace string, containerName string) (api.ContainerResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "ListContainerByName"); err != nil {
+		return api.ContainerResult{}, err
+	}
+
+	container, ok := c.containers[key{namespace, containerName}]
+	if !ok {
+		return api.ContainerResult{}, fmt.Errorf("container %q in namespace %q: %w", containerName, namespace, ErrNotFound)
+	}
+	return container, nil
+}
+
+func (c *Client) ContainerCreate(ctx context.Context, input api.ContainerCreateInput) (api.ContainerResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "ContainerCreate"); err != nil {
+		return api.ContainerResult{}, err
+	}
+	if err := c.requireNamespace(input.Namespace); err != nil {
+		return api.ContainerResult{}, err
+	}
+	k := key{input.Namespace, input.Name}
+	if _, ok := c.containers[k]; ok {
+		return api.ContainerResult{}, fmt.Errorf("container %q in namespace %q: %w", input.Name, input.Namespace, ErrExists)
+	}
+
+	mounts, err := c.applyMounts(input.Namespace, nil, input.Mounts)
+	if err != nil {
+		return api.ContainerResult{}, err
+	}
+	container := api.ContainerResult{
+		Name:                 input.Name,
+		Image:                input.Image,
+		Resources:            input.Resources,
+		Command:              input.Command,
+		Entrypoint:           input.Entrypoint,
+		EnvironmentVariables: applyEnvironment(nil, input.EnvironmentVariables),
+		Ports:                input.Ports,
+		Mounts:               mounts,
+		AvailableReplicas:    1,
+		NumberOfReplicas:     1,
+		State:                StateCreated,
+		Type:                 input.Type,
+	}
+	if input.Registry != nil {
+		container.PrivateRegistry = &api.ContainerResultPrivateRegistry{Name: *input.Registry}
+	}
+	if input.Scaling != nil && input.Scaling.Manual != nil {
+		container.AvailableReplicas = input.Scaling.Manual.Replicas
+		container.NumberOfReplicas = input.Scaling.Manual.Replicas
+	}
+
+	c.containers[k] = container
+	return container, nil
+}
+
+// ContainerModify changes the fields that are set in the input, like the API.
+func (c *Client) ContainerModify(ctx context.Context, input api.ContainerModifyInput) (api.ContainerResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "ContainerModify"); err != nil {
+		return api.ContainerResult{}, err
+	}
+	k := key{input.Namespace, input.Name}
+	container, ok := c.containers[k]
+	if !ok {
+		return api.ContainerResult{}, fmt.Errorf("container %q in namespace %q: %w", input.Name, input.Namespace, ErrNotFound)
+	}
+
+	mounts, err := c.applyMounts(input.Namespace, container.Mounts, input.Mounts)
+	if err != nil {
+		return api.ContainerResult{}, err
+	}
+	container.Mounts = mounts
+	container.EnvironmentVariables = applyEnvironment(container.EnvironmentVariables, input.EnvironmentVariables)
+	if input.Resources != nil {
+		container.Resources = *input.Resources
+	}
+	if input.Registry != nil {
+		container.PrivateRegistry = &api.ContainerResultPrivateRegistry{Name: *input.Registry}
+	}
+	if input.Image != nil {
+		container.Image = *input.Image
+	}
+	if input.Command != nil {
+		container.Command = input.Command
+	}
+	if input.Entrypoint != nil {
+		container.Entrypoint = input.Entrypoint
+	}
+	if input.Ports != nil {
+		container.Ports = input.Ports
+	}
+	if input.Scaling != nil && input.Scaling.Manual != nil {
+		container.AvailableReplicas = input.Scaling.Manual.Replicas
+		container.NumberOfReplicas = input.Scaling.Manual.Replicas
+	}
+
+	c.containers[k] = container
+	return container, nil
+}
+
+func (c *Client) ContainerDelete(ctx context.Context, namespace string, containerName string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "ContainerDelete"); err != nil {
+		return false, err
+	}
+	k := key{namespace, containerName}
+	if _, ok := c.containers[k]; !ok {
+		return false, fmt.Errorf("container %q in namespace %q: %w", containerName, namespace, ErrNotFound)
+	}
+
+	delete(c.containers, k)
+	return true, nil
+}
+
+func (c *Client) ContainerJobList(ctx context.Context, namespace string) ([]api.ContainerJobResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "ContainerJobList"); err != nil {
+		return []api.ContainerJobResult{}, err
+	}
+
+	return inNamespace(c.jobs, namespace), nil
+}
+
+func (c *Client) ContainerJobByName(ctx context.Context, namespace string, name string) (api.ContainerJobResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "ContainerJobByName"); err != nil {
+		return api.ContainerJobResult{}, err
+	}
+
+	job, ok := c.jobs[key{namespace, name}]
+	if !ok {
+		return api.ContainerJobResult{}, fmt.Errorf("container job %q in namespace %q: %w", name, namespace, ErrNotFound)
+	}
+	return job, nil
+}
+
+func (c *Client) ContainerJobCreate(ctx context.Context, input api.ContainerJobCreateInput) (api.ContainerJobResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "ContainerJobCreate"); err != nil {
+		return api.ContainerJobResult{}, err
+	}
+	if err := c.requireNamespace(input.Namespace); err != nil {
+		return api.ContainerJobResult{}, err
+	}
+	k := key{input.Namespace, input.Name}
+	if _, ok := c.jobs[k]; ok {
+		return api.ContainerJobResult{}, fmt.Errorf("container job %q in namespace %q: %w", input.Name, input.Namespace, ErrExists)
+	}
+
+	mounts, err := c.applyMounts(input.Namespace, nil, input.Mounts)
+	if err != nil {
+		return api.ContainerJobResult{}, err
+	}
+	job := api.ContainerJobResult{
+		Name:                 input.Name,
+		Image:                input.Image,
+		Namespace:            api.ContainerJobResultNamespace{Name: input.Namespace},
+		Resources:            input.Resources,
+		EnvironmentVariables: applyEnvironment(nil, input.EnvironmentVariables),
+		Command:              input.Command,
+		Entrypoint:           input.Entrypoint,
+		Mounts:               mounts,
+		Schedule:             input.Schedule,
+		Enabled:              input.Enabled,
+		State:                StateCreated,
+	}
+	if input.Registry != nil {
+		job.PrivateRegistry = &api.ContainerJobResultPrivateRegistry{Name: *input.Registry}
+	}
+
+	c.jobs[k] = job
+	return job, nil
+}
+
+// ContainerJobModify changes the fields that are set in the input, like the API.
+func (c *Client) ContainerJobModify(ctx context.Context, input api.ContainerJobModifyInput) (api.ContainerJobResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "ContainerJobModify"); err != nil {
+		return api.ContainerJobResult{}, err
+	}
+	k := key{input.Namespace, input.Name}
+	job, ok := c.jobs[k]
+	if !ok {
+		return api.ContainerJobResult{}, fmt.Errorf("container job %q in namespace %q: %w", input.Name, input.Namespace, ErrNotFound)
+	}
+
+	mounts, err := c.applyMounts(input.Namespace, job.Mounts, input.Mounts)
+	if err != nil {
+		return api.ContainerJobResult{}, err
+	}
+	job.Mounts = mounts
+	job.EnvironmentVariables = applyEnvironment(job.EnvironmentVariables, input.EnvironmentVariables)
+	if input.Resources != nil {
+		job.Resources = *input.Resources
+	}
+	if input.Registry != nil {
+		job.PrivateRegistry = &api.ContainerJobResultPrivateRegistry{Name: *input.Registry}
+	}
+	if input.Image != nil {
+		job.Image = *input.Image
+	}
+	if input.Command != nil {
+		job.Command = input.Command
+	}
+	if input.Entrypoint != nil {
+		job.Entrypoint = input.Entrypoint
+	}
+	if input.Schedule != nil {
+		job.Schedule = *input.Schedule
+	}
+	if input.Enabled != nil {
+		job.Enabled = *input.Enabled
+	}
+
+	c.jobs[k] = job
+	return job, nil
+}
+
+func (c *Client) ContainerJobDelete(ctx context.Context, namespace string, containerJobName string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "ContainerJobDelete"); err != nil {
+		return false, err
+	}
+	k := key{namespace, containerJobName}
+	if _, ok := c.jobs[k]; !ok {
+		return false, fmt.Errorf("container job %q in namespace %q: %w", containerJobName, namespace, ErrNotFound)
+	}
+
+	delete(c.jobs, k)
+	delete(c.runs, k)
+	return true, nil
+}
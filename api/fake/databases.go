@@ -0,0 +1,409 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+func (c *Client) cluster(input api.CloudDatabaseClusterResourceInput) (api.CloudDatabaseClusterResult, error) {
+	cluster, ok := c.clusters[key{input.Namespace, input.Name}]
+	if !ok {
+		return api.CloudDatabaseClusterResult{}, fmt.Errorf("cloud database cluster %q in namespace %q: %w", input.Name, input.Namespace, ErrNotFound)
+	}
+	return cluster, nil
+}
+
+func (c *Client) storeCluster(cluster api.CloudDatabaseClusterResult) {
+	c.clusters[key{cluster.Namespace.Name, cluster.Name}] = cluster
+}
+
+// applyDatabases applies changes to the databases of a cluster, a database in the ABSENT state is removed.
+func applyDatabases(current []api.CloudDatabaseClusterResultDatabasesDatabase, changes []api.DatabaseInput) []api.CloudDatabaseClusterResultDatabasesDatabase {
+	result := slices.Clone(current)
+	for _, change := range changes {
+		result = slices.DeleteFunc(result, func(database api.CloudDatabaseClusterResultDatabasesDatabase) bool {
+			return database.Name == change.Name
+		})
+		if change.State == api.StateAbsent {
+			continue
+		}
+
+		database := api.CloudDatabaseClusterDatabaseResult{Name: change.Name, Description: change.Description, Status: StateCreated}
+		result = append(result, api.CloudDatabaseClusterResultDatabasesDatabase{CloudDatabaseClusterDatabaseResult: database})
+	}
+	return result
+}
+
+// applyUser sets the password and applies the permission changes of the input to a user.
+func applyUser(user api.CloudDatabaseClusterUserResult, change api.DatabaseUserInput) api.CloudDatabaseClusterUserResult {
+	user.Name = change.Name
+	if user.Status == "" {
+		user.Status = StateCreated
+	}
+	if change.Password != nil {
+		user.Password = *change.Password
+	}
+
+	user.Permissions = slices.Clone(user.Permissions)
+	for _, permission := range change.Permissions {
+		user.Permissions = slices.DeleteFunc(user.Permissions, func(current api.CloudDatabaseClusterUserResultPermissionsDatabaseUserPermission) bool {
+			return current.DatabaseName == permission.DatabaseName
+		})
+		if permission.State == api.StateAbsent {
+			continue
+		}
+		user.Permissions = append(user.Permissions, api.CloudDatabaseClusterUserResultPermissionsDatabaseUserPermission{
+			DatabaseName: permission.DatabaseName,
+			Permission:   permission.Permission,
+		})
+	}
+	return user
+}
+
+// applyUsers applies changes to the users of a cluster, a user in the ABSENT state is removed.
+func applyUsers(current []api.CloudDatabaseClusterResultUsersDatabaseUser, changes []api.DatabaseUserInput) []api.CloudDatabaseClusterResultUsersDatabaseUser {
+	result := slices.Clone(current)
+	for _, change := range changes {
+		var user api.CloudDatabaseClusterUserResult
+		if i := findUser(result, change.Name); i >= 0 {
+			user = result[i].CloudDatabaseClusterUserResult
+			result = slices.Delete(result, i, i+1)
+		}
+		if change.State == api.StateAbsent {
+			continue
+		}
+		result = append(result, api.CloudDatabaseClusterResultUsersDatabaseUser{CloudDatabaseClusterUserResult: applyUser(user, change)})
+	}
+	return result
+}
+
+func findUser(users []api.CloudDatabaseClusterResultUsersDatabaseUser, name string) int {
+	return slices.IndexFunc(users, func(user api.CloudDatabaseClusterResultUsersDatabaseUser) bool { return user.Name == name })
+}
+
+func (c *Client) CloudDatabaseClusterList(ctx context.Context) ([]api.CloudDatabaseClusterResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "CloudDatabaseClusterList"); err != nil {
+		return []api.CloudDatabaseClusterResult{}, err
+	}
+
+	return inNamespace(c.clusters, ""), nil
+}
+
+func (c *Client) CloudDatabaseClusterGet(ctx context.Context, input api.CloudDatabaseClusterResourceInput) (api.CloudDatabaseClusterResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "CloudDatabaseClusterGet"); err != nil {
+		return api.CloudDatabaseClusterResult{}, err
+	}
+
+	return c.cluster(input)
+}
+
+// CloudDatabaseClusterCreate fails when the plan is not one of DatabasePlans.
+func (c *Client) CloudDatabaseClusterCreate(ctx context.Context, input api.CloudDatabaseClusterCreateInput) (api.CloudDatabaseClusterResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "CloudDatabaseClusterCreate"); err != nil {
+		return api.CloudDatabaseClusterResult{}, err
+	}
+	if err := c.requireNamespace(input.Namespace); err != nil {
+		return api.CloudDatabaseClusterResult{}, err
+	}
+	if _, ok := c.clusters[key{input.Namespace, input.Name}]; ok {
+		return api.CloudDatabaseClusterResult{}, fmt.Errorf("cloud database cluster %q in namespace %q: %w", input.Name, input.Namespace, ErrExists)
+	}
+	i := slices.IndexFunc(c.DatabasePlans, func(plan api.CloudDatabaseClusterPlan) bool { return plan.Id == input.Plan })
+	if i < 0 {
+		return api.CloudDatabaseClusterResult{}, fmt.Errorf("cloud database cluster plan %q: %w", input.Plan, ErrNotFound)
+	}
+	plan := c.DatabasePlans[i]
+
+	cluster := api.CloudDatabaseClusterResult{
+		Id:        c.nextId(),
+		Name:      input.Name,
+		Namespace: api.CloudDatabaseClusterResultNamespace{Name: input.Namespace},
+		Plan: api.CloudDatabaseClusterResultPlan{
+			Cpu:     plan.Cpu,
+			Group:   plan.Group,
+			Id:      plan.Id,
+			Memory:  plan.Memory,
+			Name:    plan.Name,
+			Price:   api.CloudDatabaseClusterResultPlanPrice(plan.Price),
+			Storage: plan.Storage,
+		},
+		Spec:      api.CloudDatabaseClusterResultSpec(input.Spec),
+		Databases: applyDatabases(nil, input.Databases),
+		Users:     applyUsers(nil, input.Users),
+		State:     StateCreated,
+	}
+	c.storeCluster(cluster)
+	return cluster, nil
+}
+
+func (c *Client) CloudDatabaseClusterModify(ctx context.Context, input api.CloudDatabaseClusterModifyInput) (api.CloudDatabaseClusterResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "CloudDatabaseClusterModify"); err != nil {
+		return api.CloudDatabaseClusterResult{}, err
+	}
+	cluster, err := c.cluster(api.CloudDatabaseClusterResourceInput{Name: input.Name, Namespace: input.Namespace})
+	if err != nil {
+		return api.CloudDatabaseClusterResult{}, err
+	}
+
+	cluster.Databases = applyDatabases(cluster.Databases, input.Databases)
+	cluster.Users = applyUsers(cluster.Users, input.Users)
+	c.storeCluster(cluster)
+	return cluster, nil
+}
+
+func (c *Client) CloudDatabaseClusterDelete(ctx context.Context, input api.CloudDatabaseClusterResourceInput) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "CloudDatabaseClusterDelete"); err != nil {
+		return false, err
+	}
+	if _, err := c.cluster(input); err != nil {
+		return false, err
+	}
+
+	delete(c.clusters, key{input.Namespace, input.Name})
+	delete(c.features, key{input.Namespace, input.Name})
+	return true, nil
+}
+
+func (c *Client) CloudDatabaseClusterListPlans(ctx context.Context) ([]api.CloudDatabaseClusterPlan, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "CloudDatabaseClusterListPlans"); err != nil {
+		return []api.CloudDatabaseClusterPlan{}, err
+	}
+
+	return slices.Clone(c.DatabasePlans), nil
+}
+
+func (c *Client) CloudDatabaseClusterListSpecs(ctx context.Context) ([]api.CloudDatabaseClusterSpec, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "CloudDatabaseClusterListSpecs"); err != nil {
+		return []api.CloudDatabaseClusterSpec{}, err
+	}
+
+	return slices.Clone(c.DatabaseSpecs), nil
+}
+
+func (c *Client) CloudDatabaseClusterFeatures(ctx context.Context, input api.CloudDatabaseClusterResourceInput) ([]api.CloudDatabaseClusterFeature, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "CloudDatabaseClusterFeatures"); err != nil {
+		return []api.CloudDatabaseClusterFeature{}, err
+	}
+	if _, err := c.cluster(input); err != nil {
+		return []api.CloudDatabaseClusterFeature{}, err
+	}
+
+	return slices.Clone(c.features[key{input.Namespace, input.Name}]), nil
+}
+
+func (c *Client) CloudDatabaseClusterDatabaseList(ctx context.Context, input api.CloudDatabaseClusterResourceInput) ([]api.CloudDatabaseClusterDatabaseResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "CloudDatabaseClusterDatabaseList"); err != nil {
+		return []api.CloudDatabaseClusterDatabaseResult{}, err
+	}
+	cluster, err := c.cluster(input)
+	if err != nil {
+		return []api.CloudDatabaseClusterDatabaseResult{}, err
+	}
+
+	var databases []api.CloudDatabaseClusterDatabaseResult
+	for _, database := range cluster.Databases {
+		databases = append(databases, database.CloudDatabaseClusterDatabaseResult)
+	}
+	return databases, nil
+}
+
+func (c *Client) CloudDatabaseClusterDatabaseCreate(ctx context.Context, input api.CloudDatabaseClusterDatabaseCreateInput) (api.CloudDatabaseClusterDatabaseResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "CloudDatabaseClusterDatabaseCreate"); err != nil {
+		return api.CloudDatabaseClusterDatabaseResult{}, err
+	}
+	cluster, err := c.cluster(input.Cluster)
+	if err != nil {
+		return api.CloudDatabaseClusterDatabaseResult{}, err
+	}
+	for _, database := range cluster.Databases {
+		if database.Name == input.Database.Name {
+			return api.CloudDatabaseClusterDatabaseResult{}, fmt.Errorf("database %q in cluster %q: %w", input.Database.Name, input.Cluster.Name, ErrExists)
+		}
+	}
+
+	database := input.Database
+	database.State = api.StatePresent
+	cluster.Databases = applyDatabases(cluster.Databases, []api.DatabaseInput{database})
+	c.storeCluster(cluster)
+	return cluster.Databases[len(cluster.Databases)-1].CloudDatabaseClusterDatabaseResult, nil
+}
+
+func (c *Client) CloudDatabaseClusterDatabaseDelete(ctx context.Context, input api.CloudDatabaseClusterDatabaseResourceInput) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "CloudDatabaseClusterDatabaseDelete"); err != nil {
+		return false, err
+	}
+	cluster, err := c.cluster(input.Cluster)
+	if err != nil {
+		return false, err
+	}
+	databases := applyDatabases(cluster.Databases, []api.DatabaseInput{{Name: input.Name, State: api.StateAbsent}})
+	if len(databases) == len(cluster.Databases) {
+		return false, fmt.Errorf("database %q in cluster %q: %w", input.Name, input.Cluster.Name, ErrNotFound)
+	}
+
+	cluster.Databases = databases
+	c.storeCluster(cluster)
+	return true, nil
+}
+
+func (c *Client) CloudDatabaseClusterUserList(ctx context.Context, input api.CloudDatabaseClusterResourceInput) ([]api.CloudDatabaseClusterUserResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "CloudDatabaseClusterUserList"); err != nil {
+		return []api.CloudDatabaseClusterUserResult{}, err
+	}
+	cluster, err := c.cluster(input)
+	if err != nil {
+		return []api.CloudDatabaseClusterUserResult{}, err
+	}
+
+	var users []api.CloudDatabaseClusterUserResult
+	for _, user := range cluster.Users {
+		users = append(users, user.CloudDatabaseClusterUserResult)
+	}
+	return users, nil
+}
+
+// user returns the user of the cluster, which can be the admin user.
+func (c *Client) user(input api.CloudDatabaseClusterResourceInput, name string) (api.CloudDatabaseClusterUserResult, error) {
+	cluster, err := c.cluster(input)
+	if err != nil {
+		return api.CloudDatabaseClusterUserResult{}, err
+	}
+	if cluster.AdminUser != nil && cluster.AdminUser.Name == name {
+		return cluster.AdminUser.CloudDatabaseClusterUserResult, nil
+	}
+	i := findUser(cluster.Users, name)
+	if i < 0 {
+		return api.CloudDatabaseClusterUserResult{}, fmt.Errorf("user %q in cluster %q: %w", name, input.Name, ErrNotFound)
+	}
+	return cluster.Users[i].CloudDatabaseClusterUserResult, nil
+}
+
+func (c *Client) CloudDatabaseClusterUserGet(ctx context.Context, input api.CloudDatabaseClusterResourceInput, name string) (api.CloudDatabaseClusterUserResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "CloudDatabaseClusterUserGet"); err != nil {
+		return api.CloudDatabaseClusterUserResult{}, err
+	}
+
+	return c.user(input, name)
+}
+
+func (c *Client) CloudDatabaseClusterUserCreate(ctx context.Context, input api.CloudDatabaseClusterUserCreateInput) (api.CloudDatabaseClusterUserResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "CloudDatabaseClusterUserCreate"); err != nil {
+		return api.CloudDatabaseClusterUserResult{}, err
+	}
+	cluster, err := c.cluster(input.Cluster)
+	if err != nil {
+		return api.CloudDatabaseClusterUserResult{}, err
+	}
+	if findUser(cluster.Users, input.User.Name) >= 0 {
+		return api.CloudDatabaseClusterUserResult{}, fmt.Errorf("user %q in cluster %q: %w", input.User.Name, input.Cluster.Name, ErrExists)
+	}
+
+	user := input.User
+	user.State = api.StatePresent
+	cluster.Users = applyUsers(cluster.Users, []api.DatabaseUserInput{user})
+	c.storeCluster(cluster)
+	return cluster.Users[len(cluster.Users)-1].CloudDatabaseClusterUserResult, nil
+}
+
+func (c *Client) CloudDatabaseClusterUserModify(ctx context.Context, input api.CloudDatabaseClusterUserModifyInput) (api.CloudDatabaseClusterUserResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "CloudDatabaseClusterUserModify"); err != nil {
+		return api.CloudDatabaseClusterUserResult{}, err
+	}
+	if input.Cluster == nil || input.User == nil {
+		return api.CloudDatabaseClusterUserResult{}, fmt.Errorf("cluster and user are required")
+	}
+	cluster, err := c.cluster(*input.Cluster)
+	if err != nil {
+		return api.CloudDatabaseClusterUserResult{}, err
+	}
+
+	if cluster.AdminUser != nil && cluster.AdminUser.Name == input.User.Name {
+		admin := applyUser(cluster.AdminUser.CloudDatabaseClusterUserResult, *input.User)
+		cluster.AdminUser = &api.CloudDatabaseClusterResultAdminUserDatabaseUser{CloudDatabaseClusterUserResult: admin}
+		c.storeCluster(cluster)
+		return admin, nil
+	}
+
+	i := findUser(cluster.Users, input.User.Name)
+	if i < 0 {
+		return api.CloudDatabaseClusterUserResult{}, fmt.Errorf("user %q in cluster %q: %w", input.User.Name, input.Cluster.Name, ErrNotFound)
+	}
+	user := applyUser(cluster.Users[i].CloudDatabaseClusterUserResult, *input.User)
+	cluster.Users = slices.Clone(cluster.Users)
+	cluster.Users[i] = api.CloudDatabaseClusterResultUsersDatabaseUser{CloudDatabaseClusterUserResult: user}
+	c.storeCluster(cluster)
+	return user, nil
+}
+
+func (c *Client) CloudDatabaseClusterUserDelete(ctx context.Context, input api.CloudDatabaseClusterUserResourceInput) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "CloudDatabaseClusterUserDelete"); err != nil {
+		return false, err
+	}
+	cluster, err := c.cluster(input.Cluster)
+	if err != nil {
+		return false, err
+	}
+	if findUser(cluster.Users, input.Name) < 0 {
+		return false, fmt.Errorf("user %q in cluster %q: %w", input.Name, input.Cluster.Name, ErrNotFound)
+	}
+
+	cluster.Users = applyUsers(cluster.Users, []api.DatabaseUserInput{{Name: input.Name, State: api.StateAbsent}})
+	c.storeCluster(cluster)
+	return true, nil
+}
+
+func (c *Client) CloudDatabaseClusterUserCredentials(ctx context.Context, cloudDatabase api.CloudDatabaseClusterResourceInput, userName string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "CloudDatabaseClusterUserCredentials"); err != nil {
+		return "", err
+	}
+
+	user, err := c.user(cloudDatabase, userName)
+	return user.Dsn, err
+}
+
+func (c *Client) CloudDatabaseClusterUserCredentialsGet(ctx context.Context, cloudDatabase api.CloudDatabaseClusterResourceInput, userName string) (api.CloudDatabaseClusterUserResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "CloudDatabaseClusterUserCredentialsGet"); err != nil {
+		return api.CloudDatabaseClusterUserResult{}, err
+	}
+
+	return c.user(cloudDatabase, userName)
+}
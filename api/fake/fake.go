@@ -0,0 +1,261 @@
+// Package fake implements the services of the api package in memory, so code that uses them can be
+// tested without the API.
+//
+// Resources are stored as the result types the API returns. Tests add them with the Add methods or
+// create them through the services, and read them back with the get and list methods. Changes are
+// applied at once, created resources are in the CREATED state and have no fields the fake cannot
+// derive from the input, such as ingresses, health checks, external connections and DSNs.
+package fake
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+// StateCreated is the state of every resource created by the fake.
+const StateCreated = "CREATED"
+
+var (
+	// ErrNotFound is returned, wrapped, for resources that do not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrExists is returned, wrapped, when a resource with the same name already exists.
+	ErrExists = errors.New("already exists")
+)
+
+// key identifies a resource in a namespace.
+type key struct {
+	namespace string
+	name      string
+}
+
+// Client implements api.Services in memory. It is safe for concurrent use.
+type Client struct {
+	mu sync.Mutex
+
+	namespaces map[string]api.NamespaceResult
+	containers map[key]api.ContainerResult
+	jobs       map[key]api.ContainerJobResult
+	volumes    map[key]api.VolumeResult
+	registries map[key]api.RegistryResult
+	clusters   map[key]api.CloudDatabaseClusterResult
+	features   map[key][]api.CloudDatabaseClusterFeature
+	runs       map[key][]api.NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun
+	queues     map[key]api.MessageQueueResult
+	passwords  map[key]string
+	lastId     int
+
+	// The plans, versions and prices offered, they cannot be changed through the API.
+	QueuePlans     []api.MessageQueuePlanResult
+	QueueVersions  []api.MessageQueueVersionResult
+	DatabasePlans  []api.CloudDatabaseClusterPlan
+	DatabaseSpecs  []api.CloudDatabaseClusterSpec
+	Specifications []api.ResourceSpecificationResult
+
+	// AccountId is the customer id returned by GetAccountId.
+	AccountId int
+
+	// Logs are returned by AuditLogs for every customer.
+	Logs []api.AuditLogResult
+
+	// Errors makes the method with the given name, such as "ContainerCreate", fail with the error.
+	Errors map[string]error
+}
+
+var _ api.Services = (*Client)(nil)
+
+// New returns an empty client.
+func New() *Client {
+	return &Client{
+		namespaces: map[string]api.NamespaceResult{},
+		containers: map[key]api.ContainerResult{},
+		jobs:       map[key]api.ContainerJobResult{},
+		volumes:    map[key]api.VolumeResult{},
+		registries: map[key]api.RegistryResult{},
+		clusters:   map[key]api.CloudDatabaseClusterResult{},
+		features:   map[key][]api.CloudDatabaseClusterFeature{},
+		runs:       map[key][]api.NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun{},
+		queues:     map[key]api.MessageQueueResult{},
+		passwords:  map[key]string{},
+		Errors:     map[string]error{},
+	}
+}
+
+// fail returns the error method should fail with: the error of a cancelled context or the error set in Errors.
+func (c *Client) fail(ctx context.Context, method string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return c.Errors[method]
+}
+
+// nextId returns a new id for a cluster or queue.
+func (c *Client) nextId() string {
+	c.lastId++
+	return strconv.Itoa(c.lastId)
+}
+
+// requireNamespace returns an error when the namespace does not exist.
+func (c *Client) requireNamespace(namespace string) error {
+	if _, ok := c.namespaces[namespace]; !ok {
+		return fmt.Errorf("namespace %q: %w", namespace, ErrNotFound)
+	}
+	return nil
+}
+
+// inNamespace returns the resources in the namespace, or in every namespace when it is empty, sorted by
+// namespace and name.
+func inNamespace[T any](resources map[key]T, namespace string) []T {
+	var keys []key
+	for k := range resources {
+		if namespace == "" || k.namespace == namespace {
+			keys = append(keys, k)
+		}
+	}
+	slices.SortFunc(keys, func(a, b key) int {
+		return cmp.Or(cmp.Compare(a.namespace, b.namespace), cmp.Compare(a.name, b.name))
+	})
+
+	values := make([]T, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, resources[k])
+	}
+	return values
+}
+
+// AddNamespace stores a namespace. The resources listed in it are ignored, they are derived from the
+// resources added to the namespace.
+func (c *Client) AddNamespace(namespace api.NamespaceResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.addNamespace(namespace)
+}
+
+func (c *Client) addNamespace(namespace api.NamespaceResult) {
+	if namespace.State == "" {
+		namespace.State = StateCreated
+	}
+	c.namespaces[namespace.Name] = api.NamespaceResult{Name: namespace.Name, Description: namespace.Description, State: namespace.State}
+}
+
+// ensureNamespace creates the namespace when the resource added to it is the first.
+func (c *Client) ensureNamespace(name string) {
+	if _, ok := c.namespaces[name]; !ok {
+		c.addNamespace(api.NamespaceResult{Name: name})
+	}
+}
+
+// AddContainer stores a container in the namespace, the namespace is created when it does not exist.
+func (c *Client) AddContainer(namespace string, container api.ContainerResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ensureNamespace(namespace)
+	c.containers[key{namespace, container.Name}] = container
+}
+
+// AddContainerJob stores a container job in its namespace, the namespace is created when it does not exist.
+func (c *Client) AddContainerJob(job api.ContainerJobResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ensureNamespace(job.Namespace.Name)
+	c.jobs[key{job.Namespace.Name, job.Name}] = job
+}
+
+// SetContainerJobRuns sets the runs NamespaceDescribe returns for a container job.
+func (c *Client) SetContainerJobRuns(namespace string, name string, runs []api.NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.runs[key{namespace, name}] = runs
+}
+
+// AddVolume stores a volume in the namespace, the namespace is created when it does not exist. The
+// containers and jobs using it are derived from their mounts.
+func (c *Client) AddVolume(namespace string, volume api.VolumeResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ensureNamespace(namespace)
+	c.volumes[key{namespace, volume.Name}] = volume
+}
+
+// AddRegistry stores a private registry in the namespace, the namespace is created when it does not exist.
+func (c *Client) AddRegistry(namespace string, registry api.RegistryResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ensureNamespace(namespace)
+	c.registries[key{namespace, registry.Name}] = registry
+}
+
+// AddCloudDatabaseCluster stores a cluster in its namespace, the namespace is created when it does not exist.
+func (c *Client) AddCloudDatabaseCluster(cluster api.CloudDatabaseClusterResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ensureNamespace(cluster.Namespace.Name)
+	c.clusters[key{cluster.Namespace.Name, cluster.Name}] = cluster
+}
+
+// SetCloudDatabaseClusterFeatures sets the features returned for a cluster.
+func (c *Client) SetCloudDatabaseClusterFeatures(namespace string, name string, features []api.CloudDatabaseClusterFeature) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.features[key{namespace, name}] = features
+}
+
+// AddMessageQueue stores a message queue in its namespace, the namespace is created when it does not exist.
+func (c *Client) AddMessageQueue(queue api.MessageQueueResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ensureNamespace(queue.Namespace.Name)
+	c.queues[key{queue.Namespace.Name, queue.Name}] = queue
+}
+
+func (c *Client) ResourceSpecifications(ctx context.Context, kind string) ([]api.ResourceSpecificationResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "ResourceSpecifications"); err != nil {
+		return []api.ResourceSpecificationResult{}, err
+	}
+
+	var specifications []api.ResourceSpecificationResult
+	for _, specification := range c.Specifications {
+		if specification.Kind == kind {
+			specifications = append(specifications, specification)
+		}
+	}
+	return specifications, nil
+}
+
+func (c *Client) GetAccountId(ctx context.Context) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "GetAccountId"); err != nil {
+		return 0, err
+	}
+
+	return c.AccountId, nil
+}
+
+func (c *Client) AuditLogs(ctx context.Context, customerId int, filter *api.AuditLogFilterInput) ([]api.AuditLogResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "AuditLogs"); err != nil {
+		return []api.AuditLogResult{}, err
+	}
+
+	var logs []api.AuditLogResult
+	for _, entry := range c.Logs {
+		if filter != nil && len(filter.EventTypes) > 0 && !slices.Contains(filter.EventTypes, entry.EventType) {
+			continue
+		}
+		if filter != nil && len(filter.ModelNames) > 0 && !slices.Contains(filter.ModelNames, entry.ModelName) {
+			continue
+		}
+		logs = append(logs, entry)
+	}
+	return logs, nil
+}
@@ -0,0 +1,178 @@
+package fake
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespaces(t *testing.T) {
+	ctx := context.Background()
+	client := New()
+
+	description := "Production"
+	_, err := client.NamespaceCreate(ctx, api.NamespaceCreateInput{Name: "production", Description: &description})
+	assert.NoError(t, err)
+	_, err = client.NamespaceCreate(ctx, api.NamespaceCreateInput{Name: "production"})
+	assert.ErrorIs(t, err, ErrExists)
+
+	client.AddContainer("staging", api.ContainerResult{Name: "web", State: StateCreated})
+
+	namespaces, err := client.NamespacesList(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []api.NamespaceResult{
+		{Name: "production", Description: "Production", State: StateCreated},
+		{Name: "staging", State: StateCreated, Containers: []api.NamespaceResultContainersContainer{{Name: "web"}}},
+	}, namespaces)
+
+	_, err = client.NamespaceDelete(ctx, "staging")
+	assert.Error(t, err, "a namespace with resources cannot be deleted")
+
+	deleted, err := client.NamespaceDelete(ctx, "production")
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+
+	_, err = client.NamespaceListByName(ctx, "production")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestContainers(t *testing.T) {
+	ctx := context.Background()
+	client := New()
+	client.AddNamespace(api.NamespaceResult{Name: "production"})
+
+	size := 5
+	_, err := client.ContainerCreate(ctx, api.ContainerCreateInput{
+		Name:                 "web",
+		Namespace:            "production",
+		Image:                "nginx:1",
+		Resources:            api.ContainerResourcesCpu250Ram500,
+		EnvironmentVariables: []api.EnvironmentVariableInput{{Name: "MODE", Value: "production", State: api.StatePresent}},
+		Mounts:               []api.MountInput{{Path: "/data", Volume: api.MountVolumeInput{Name: "data", AutoCreate: true, Size: &size}}},
+	})
+	assert.NoError(t, err)
+
+	image := "nginx:2"
+	container, err := client.ContainerModify(ctx, api.ContainerModifyInput{
+		Name:      "web",
+		Namespace: "production",
+		Image:     &image,
+		EnvironmentVariables: []api.EnvironmentVariableInput{
+			{Name: "MODE", State: api.StateAbsent},
+			{Name: "TOKEN", Value: "secret", Secret: true, State: api.StatePresent},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "nginx:2", container.Image)
+	assert.Equal(t, api.ContainerResourcesCpu250Ram500, container.Resources)
+	assert.Len(t, container.EnvironmentVariables, 1)
+	assert.Equal(t, "TOKEN", container.EnvironmentVariables[0].Name)
+
+	volume, err := client.ListVolumeByName(ctx, "production", "data")
+	assert.NoError(t, err)
+	assert.Equal(t, 5.0, volume.Size)
+	assert.Equal(t, []api.VolumeResultContainersContainer{{Name: "web"}}, volume.Containers)
+
+	_, err = client.VolumeDelete(ctx, "production", "data")
+	assert.Error(t, err, "a mounted volume cannot be deleted")
+
+	_, err = client.ContainerDelete(ctx, "production", "web")
+	assert.NoError(t, err)
+	_, err = client.VolumeDelete(ctx, "production", "data")
+	assert.NoError(t, err)
+
+	_, err = client.ListContainerByName(ctx, "production", "web")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCloudDatabaseClusterUsers(t *testing.T) {
+	ctx := context.Background()
+	client := New()
+	client.AddNamespace(api.NamespaceResult{Name: "production"})
+	client.DatabasePlans = []api.CloudDatabaseClusterPlan{{Id: "plan-1", Name: "Small"}}
+
+	cluster := api.CloudDatabaseClusterResourceInput{Name: "db", Namespace: "production"}
+	_, err := client.CloudDatabaseClusterCreate(ctx, api.CloudDatabaseClusterCreateInput{Name: "db", Namespace: "production", Plan: "unknown"})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	created, err := client.CloudDatabaseClusterCreate(ctx, api.CloudDatabaseClusterCreateInput{
+		Name:      "db",
+		Namespace: "production",
+		Plan:      "plan-1",
+		Databases: []api.DatabaseInput{{Name: "app", State: api.StatePresent}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Small", created.Plan.Name)
+
+	password := "first"
+	_, err = client.CloudDatabaseClusterUserCreate(ctx, api.CloudDatabaseClusterUserCreateInput{
+		Cluster: cluster,
+		User: api.DatabaseUserInput{Name: "app", Password: &password, Permissions: []api.DatabaseUserPermissionInput{
+			{DatabaseName: "app", Permission: api.DatabasePermissionReadOnly, State: api.StatePresent},
+		}},
+	})
+	assert.NoError(t, err)
+
+	password = "second"
+	user, err := client.CloudDatabaseClusterUserModify(ctx, api.CloudDatabaseClusterUserModifyInput{
+		Cluster: &cluster,
+		User: &api.DatabaseUserInput{Name: "app", Password: &password, Permissions: []api.DatabaseUserPermissionInput{
+			{DatabaseName: "app", Permission: api.DatabasePermissionReadWrite, State: api.StatePresent},
+		}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "second", user.Password)
+	assert.Equal(t, []api.CloudDatabaseClusterUserResultPermissionsDatabaseUserPermission{
+		{DatabaseName: "app", Permission: api.DatabasePermissionReadWrite},
+	}, user.Permissions)
+
+	users, err := client.CloudDatabaseClusterUserList(ctx, cluster)
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+
+	databases, err := client.CloudDatabaseClusterDatabaseList(ctx, cluster)
+	assert.NoError(t, err)
+	assert.Equal(t, "app", databases[0].Name)
+}
+
+func TestMessageQueueAdminPassword(t *testing.T) {
+	ctx := context.Background()
+	client := New()
+	client.AddNamespace(api.NamespaceResult{Name: "production"})
+	client.QueuePlans = []api.MessageQueuePlanResult{{Id: "plan-1"}}
+
+	queue := api.MessageQueueResourceInput{Name: "events", Namespace: "production"}
+	_, err := client.MessageQueueCreate(ctx, api.MessageQueueCreateInput{Name: "events", Namespace: "production", Plan: "plan-1"})
+	assert.NoError(t, err)
+
+	password := "rotated"
+	_, err = client.MessageQueueUserModify(ctx, api.CloudDatabaseClusterUserModifyInput{
+		Cluster: &api.CloudDatabaseClusterResourceInput{Name: queue.Name, Namespace: queue.Namespace},
+		User:    &api.DatabaseUserInput{Name: QueueAdminUser, Password: &password},
+	})
+	assert.NoError(t, err)
+
+	credentials, err := client.MessageQueueAdminCredentials(ctx, queue, QueueAdminUser)
+	assert.NoError(t, err)
+	assert.Equal(t, "rotated", credentials.Password)
+
+	_, err = client.MessageQueueAdminCredentials(ctx, queue, "other")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestErrors(t *testing.T) {
+	client := New()
+	failure := errors.New("unavailable")
+	client.Errors["NamespacesList"] = failure
+
+	_, err := client.NamespacesList(context.Background())
+	assert.ErrorIs(t, err, failure)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.ListContainers(ctx, "production")
+	assert.ErrorIs(t, err, context.Canceled)
+}
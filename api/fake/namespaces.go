@@ -0,0 +1,169 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+// namespaceResult returns the namespace with the names of the resources in it.
+func (c *Client) namespaceResult(name string) api.NamespaceResult {
+	namespace := c.namespaces[name]
+	for _, container := range inNamespace(c.containers, name) {
+		namespace.Containers = append(namespace.Containers, api.NamespaceResultContainersContainer{Name: container.Name})
+	}
+	for _, job := range inNamespace(c.jobs, name) {
+		namespace.ContainerJobs = append(namespace.ContainerJobs, api.NamespaceResultContainerJobsContainerJob{Name: job.Name})
+	}
+	for _, volume := range inNamespace(c.volumes, name) {
+		namespace.Volumes = append(namespace.Volumes, api.NamespaceResultVolumesVolume{Name: volume.Name})
+	}
+	for _, cluster := range inNamespace(c.clusters, name) {
+		namespace.CloudDatabaseClusters = append(namespace.CloudDatabaseClusters, api.NamespaceResultCloudDatabaseClustersCloudDatabaseCluster{Name: cluster.Name})
+	}
+	for _, queue := range inNamespace(c.queues, name) {
+		namespace.MessageQueues = append(namespace.MessageQueues, api.NamespaceResultMessageQueuesMessageQueue{Name: queue.Name})
+	}
+	for _, registry := range inNamespace(c.registries, name) {
+		namespace.PrivateRegistries = append(namespace.PrivateRegistries, api.NamespaceResultPrivateRegistriesPrivateRegistry{Name: registry.Name})
+	}
+	return namespace
+}
+
+func (c *Client) NamespacesList(ctx context.Context) ([]api.NamespaceResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "NamespacesList"); err != nil {
+		return []api.NamespaceResult{}, err
+	}
+
+	names := make([]string, 0, len(c.namespaces))
+	for name := range c.namespaces {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	namespaces := make([]api.NamespaceResult, 0, len(names))
+	for _, name := range names {
+		namespaces = append(namespaces, c.namespaceResult(name))
+	}
+	return namespaces, nil
+}
+
+func (c *Client) NamespaceListByName(ctx context.Context, name string) (api.NamespaceResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "NamespaceListByName"); err != nil {
+		return api.NamespaceResult{}, err
+	}
+	if err := c.requireNamespace(name); err != nil {
+		return api.NamespaceResult{}, err
+	}
+
+	return c.namespaceResult(name), nil
+}
+
+func (c *Client) NamespaceCreate(ctx context.Context, input api.NamespaceCreateInput) (api.NamespaceResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "NamespaceCreate"); err != nil {
+		return api.NamespaceResult{}, err
+	}
+	if _, ok := c.namespaces[input.Name]; ok {
+		return api.NamespaceResult{}, fmt.Errorf("namespace %q: %w", input.Name, ErrExists)
+	}
+
+	namespace := api.NamespaceResult{Name: input.Name}
+	if input.Description != nil {
+		namespace.Description = *input.Description
+	}
+	c.addNamespace(namespace)
+	return c.namespaceResult(input.Name), nil
+}
+
+// NamespaceDelete fails like the API when the namespace still has resources.
+func (c *Client) NamespaceDelete(ctx context.Context, name string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "NamespaceDelete"); err != nil {
+		return false, err
+	}
+	if err := c.requireNamespace(name); err != nil {
+		return false, err
+	}
+
+	namespace := c.namespaceResult(name)
+	if len(namespace.Containers)+len(namespace.ContainerJobs)+len(namespace.Volumes)+len(namespace.CloudDatabaseClusters)+
+		len(namespace.MessageQueues)+len(namespace.PrivateRegistries) > 0 {
+		return false, fmt.Errorf("namespace %q is not empty", name)
+	}
+
+	delete(c.namespaces, name)
+	return true, nil
+}
+
+func (c *Client) NamespaceDescribe(ctx context.Context, name string) (api.NamespaceDescription, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "NamespaceDescribe"); err != nil {
+		return api.NamespaceDescription{}, err
+	}
+	if err := c.requireNamespace(name); err != nil {
+		return api.NamespaceDescription{}, err
+	}
+
+	namespace := c.namespaces[name]
+	description := api.NamespaceDescription{Name: namespace.Name, Description: namespace.Description, State: namespace.State}
+	for _, container := range inNamespace(c.containers, name) {
+		description.Containers = append(description.Containers, api.NamespaceDescriptionContainersContainer{
+			Name:              container.Name,
+			State:             container.State,
+			Locked:            container.Locked,
+			AvailableReplicas: container.AvailableReplicas,
+			NumberOfReplicas:  container.NumberOfReplicas,
+		})
+	}
+	for _, job := range inNamespace(c.jobs, name) {
+		description.ContainerJobs = append(description.ContainerJobs, api.NamespaceDescriptionContainerJobsContainerJob{
+			Name:     job.Name,
+			State:    job.State,
+			Locked:   job.Locked,
+			Enabled:  job.Enabled,
+			Schedule: job.Schedule,
+			Runs:     c.runs[key{name, job.Name}],
+		})
+	}
+	for _, volume := range inNamespace(c.volumes, name) {
+		description.Volumes = append(description.Volumes, api.NamespaceDescriptionVolumesVolume{
+			Name:   volume.Name,
+			State:  volume.State,
+			Locked: volume.Locked,
+			Size:   volume.Size,
+			Usage:  volume.Usage,
+		})
+	}
+	for _, cluster := range inNamespace(c.clusters, name) {
+		description.CloudDatabaseClusters = append(description.CloudDatabaseClusters, api.NamespaceDescriptionCloudDatabaseClustersCloudDatabaseCluster{
+			Name:   cluster.Name,
+			State:  cluster.State,
+			Locked: cluster.Locked,
+		})
+	}
+	for _, queue := range inNamespace(c.queues, name) {
+		description.MessageQueues = append(description.MessageQueues, api.NamespaceDescriptionMessageQueuesMessageQueue{
+			Name:   queue.Name,
+			State:  queue.State,
+			Locked: queue.Locked,
+		})
+	}
+	for _, registry := range inNamespace(c.registries, name) {
+		description.PrivateRegistries = append(description.PrivateRegistries, api.NamespaceDescriptionPrivateRegistriesPrivateRegistry{
+			Name:   registry.Name,
+			State:  registry.State,
+			Locked: registry.Locked,
+		})
+	}
+	return description, nil
+}
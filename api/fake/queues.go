@@ -0,0 +1,196 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+// QueueAdminUser is the name of the admin user of the message queues created by the fake.
+const QueueAdminUser = "admin"
+
+func (c *Client) queue(input api.MessageQueueResourceInput) (api.MessageQueueResult, error) {
+	queue, ok := c.queues[key{input.Namespace, input.Name}]
+	if !ok {
+		return api.MessageQueueResult{}, fmt.Errorf("message queue %q in namespace %q: %w", input.Name, input.Namespace, ErrNotFound)
+	}
+	return queue, nil
+}
+
+// applyAllowList applies changes to an allowlist, an address in the ABSENT state is removed.
+func applyAllowList(current []string, changes []api.AllowListInput) []string {
+	result := slices.Clone(current)
+	for _, change := range changes {
+		result = slices.DeleteFunc(result, func(ip string) bool { return ip == change.Ip })
+		if change.State != api.StateAbsent {
+			result = append(result, change.Ip)
+		}
+	}
+	return result
+}
+
+func (c *Client) MessageQueueList(ctx context.Context) ([]api.MessageQueueResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "MessageQueueList"); err != nil {
+		return []api.MessageQueueResult{}, err
+	}
+
+	return inNamespace(c.queues, ""), nil
+}
+
+func (c *Client) MessageQueueGet(ctx context.Context, input api.MessageQueueResourceInput) (api.MessageQueueResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "MessageQueueGet"); err != nil {
+		return api.MessageQueueResult{}, err
+	}
+
+	return c.queue(input)
+}
+
+// MessageQueueCreate fails when the plan is not one of QueuePlans. The queue gets an admin user named
+// QueueAdminUser.
+func (c *Client) MessageQueueCreate(ctx context.Context, input api.MessageQueueCreateInput) (api.MessageQueueResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "MessageQueueCreate"); err != nil {
+		return api.MessageQueueResult{}, err
+	}
+	if err := c.requireNamespace(input.Namespace); err != nil {
+		return api.MessageQueueResult{}, err
+	}
+	k := key{input.Namespace, input.Name}
+	if _, ok := c.queues[k]; ok {
+		return api.MessageQueueResult{}, fmt.Errorf("message queue %q in namespace %q: %w", input.Name, input.Namespace, ErrExists)
+	}
+	i := slices.IndexFunc(c.QueuePlans, func(plan api.MessageQueuePlanResult) bool { return plan.Id == input.Plan })
+	if i < 0 {
+		return api.MessageQueueResult{}, fmt.Errorf("message queue plan %q: %w", input.Plan, ErrNotFound)
+	}
+
+	version := api.MessageQueueVersionResult{Type: input.Spec.Type, Version: input.Spec.Version}
+	for _, available := range c.QueueVersions {
+		if available.Type == version.Type && available.Version == version.Version {
+			version = available
+		}
+	}
+
+	queue := api.MessageQueueResult{
+		Id:        c.nextId(),
+		Name:      input.Name,
+		State:     StateCreated,
+		Namespace: api.MessageQueueResultNamespace{Name: input.Namespace},
+		AdminUser: &api.MessageQueueResultAdminUserMessageQueueUser{Name: QueueAdminUser, Status: StateCreated},
+		Plan:      api.MessageQueueResultPlanMessageQueuePlan{MessageQueuePlanResult: c.QueuePlans[i]},
+		Spec:      api.MessageQueueResultSpecMessageQueueSpec{MessageQueueVersionResult: version},
+		Ingress: api.MessageQueueResultIngressMessageQueueIngress{MessageQueueIngressResult: api.MessageQueueIngressResult{
+			AllowList: applyAllowList(nil, input.AllowList),
+			Status:    api.StatusCreated,
+		}},
+	}
+	c.queues[k] = queue
+	return queue, nil
+}
+
+func (c *Client) MessageQueueModify(ctx context.Context, input api.MessageQueueModifyInput) (api.MessageQueueResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "MessageQueueModify"); err != nil {
+		return api.MessageQueueResult{}, err
+	}
+	queue, err := c.queue(api.MessageQueueResourceInput{Name: input.Name, Namespace: input.Namespace})
+	if err != nil {
+		return api.MessageQueueResult{}, err
+	}
+
+	queue.Ingress.AllowList = applyAllowList(queue.Ingress.AllowList, input.AllowList)
+	c.queues[key{input.Namespace, input.Name}] = queue
+	return queue, nil
+}
+
+func (c *Client) MessageQueueDelete(ctx context.Context, input api.MessageQueueResourceInput) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "MessageQueueDelete"); err != nil {
+		return false, err
+	}
+	if _, err := c.queue(input); err != nil {
+		return false, err
+	}
+
+	delete(c.queues, key{input.Namespace, input.Name})
+	delete(c.passwords, key{input.Namespace, input.Name})
+	return true, nil
+}
+
+func (c *Client) MessageQueuePlans(ctx context.Context) ([]api.MessageQueuePlanResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "MessageQueuePlans"); err != nil {
+		return []api.MessageQueuePlanResult{}, err
+	}
+
+	return slices.Clone(c.QueuePlans), nil
+}
+
+func (c *Client) MessageQueueVersions(ctx context.Context) ([]api.MessageQueueVersionResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "MessageQueueVersions"); err != nil {
+		return []api.MessageQueueVersionResult{}, err
+	}
+
+	return slices.Clone(c.QueueVersions), nil
+}
+
+// adminCredentials returns the credentials of the admin user of the queue, which must be named username.
+func (c *Client) adminCredentials(input api.MessageQueueResourceInput, username string) (api.MessageQueueUserCredentialsResult, error) {
+	queue, err := c.queue(input)
+	if err != nil {
+		return api.MessageQueueUserCredentialsResult{}, err
+	}
+	if queue.AdminUser == nil || queue.AdminUser.Name != username {
+		return api.MessageQueueUserCredentialsResult{}, fmt.Errorf("user %q of message queue %q: %w", username, input.Name, ErrNotFound)
+	}
+
+	return api.MessageQueueUserCredentialsResult{
+		Name:     queue.AdminUser.Name,
+		Password: c.passwords[key{input.Namespace, input.Name}],
+		Role:     queue.AdminUser.Role,
+		Status:   queue.AdminUser.Status,
+	}, nil
+}
+
+func (c *Client) MessageQueueAdminCredentials(ctx context.Context, input api.MessageQueueResourceInput, username string) (api.MessageQueueUserCredentialsResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "MessageQueueAdminCredentials"); err != nil {
+		return api.MessageQueueUserCredentialsResult{}, err
+	}
+
+	return c.adminCredentials(input, username)
+}
+
+// MessageQueueUserModify changes the password of the admin user, input.Cluster names the queue.
+func (c *Client) MessageQueueUserModify(ctx context.Context, input api.CloudDatabaseClusterUserModifyInput) (api.MessageQueueUserCredentialsResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "MessageQueueUserModify"); err != nil {
+		return api.MessageQueueUserCredentialsResult{}, err
+	}
+	if input.Cluster == nil || input.User == nil {
+		return api.MessageQueueUserCredentialsResult{}, fmt.Errorf("cluster and user are required")
+	}
+
+	resource := api.MessageQueueResourceInput{Name: input.Cluster.Name, Namespace: input.Cluster.Namespace}
+	if _, err := c.adminCredentials(resource, input.User.Name); err != nil {
+		return api.MessageQueueUserCredentialsResult{}, err
+	}
+	if input.User.Password != nil {
+		c.passwords[key{resource.Namespace, resource.Name}] = *input.User.Password
+	}
+	return c.adminCredentials(resource, input.User.Name)
+}
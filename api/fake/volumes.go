@@ -0,0 +1,182 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+// volumeResult returns the volume with the containers and jobs that mount it.
+func (c *Client) volumeResult(namespace string, volume api.VolumeResult) api.VolumeResult {
+	volume.Containers = nil
+	volume.ContainerJobs = nil
+	for _, container := range inNamespace(c.containers, namespace) {
+		if mountsVolume(container.Mounts, volume.Name) {
+			volume.Containers = append(volume.Containers, api.VolumeResultContainersContainer{Name: container.Name})
+		}
+	}
+	for _, job := range inNamespace(c.jobs, namespace) {
+		if mountsVolume(job.Mounts, volume.Name) {
+			volume.ContainerJobs = append(volume.ContainerJobs, api.VolumeResultContainerJobsContainerJob{Name: job.Name})
+		}
+	}
+	return volume
+}
+
+func mountsVolume(mounts []api.ContainerMounts, name string) bool {
+	for _, mount := range mounts {
+		if mount.Volume.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Client) ListVolumes(ctx context.Context, namespace string) ([]api.VolumeResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "ListVolumes"); err != nil {
+		return []api.VolumeResult{}, err
+	}
+
+	volumes := inNamespace(c.volumes, namespace)
+	for i, volume := range volumes {
+		volumes[i] = c.volumeResult(namespace, volume)
+	}
+	return volumes, nil
+}
+
+func (c *Client) ListVolumeByName(ctx context.Context, namespace string, volumeName string) (*api.VolumeResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "ListVolumeByName"); err != nil {
+		return nil, err
+	}
+
+	volume, ok := c.volumes[key{namespace, volumeName}]
+	if !ok {
+		return nil, fmt.Errorf("volume %q in namespace %q: %w", volumeName, namespace, ErrNotFound)
+	}
+	volume = c.volumeResult(namespace, volume)
+	return &volume, nil
+}
+
+func (c *Client) VolumeCreate(ctx context.Context, input api.VolumeCreateInput) (api.VolumeResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "VolumeCreate"); err != nil {
+		return api.VolumeResult{}, err
+	}
+	if err := c.requireNamespace(input.Namespace); err != nil {
+		return api.VolumeResult{}, err
+	}
+	k := key{input.Namespace, input.Name}
+	if _, ok := c.volumes[k]; ok {
+		return api.VolumeResult{}, fmt.Errorf("volume %q in namespace %q: %w", input.Name, input.Namespace, ErrExists)
+	}
+
+	volume := api.VolumeResult{Name: input.Name, Size: float64(input.Size), State: StateCreated}
+	c.volumes[k] = volume
+	return volume, nil
+}
+
+// VolumeIncrease fails like the API when the new size is not larger than the current size.
+func (c *Client) VolumeIncrease(ctx context.Context, input api.VolumeModifyInput) (api.VolumeResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "VolumeIncrease"); err != nil {
+		return api.VolumeResult{}, err
+	}
+	k := key{input.Namespace, input.Name}
+	volume, ok := c.volumes[k]
+	if !ok {
+		return api.VolumeResult{}, fmt.Errorf("volume %q in namespace %q: %w", input.Name, input.Namespace, ErrNotFound)
+	}
+	if float64(input.Size) <= volume.Size {
+		return api.VolumeResult{}, fmt.Errorf("volume %q can only grow, it is %g GB", input.Name, volume.Size)
+	}
+
+	volume.Size = float64(input.Size)
+	c.volumes[k] = volume
+	return c.volumeResult(input.Namespace, volume), nil
+}
+
+// VolumeDelete fails like the API when a container or job still mounts the volume.
+func (c *Client) VolumeDelete(ctx context.Context, namespace string, volumeName string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "VolumeDelete"); err != nil {
+		return false, err
+	}
+	k := key{namespace, volumeName}
+	volume, ok := c.volumes[k]
+	if !ok {
+		return false, fmt.Errorf("volume %q in namespace %q: %w", volumeName, namespace, ErrNotFound)
+	}
+	volume = c.volumeResult(namespace, volume)
+	if len(volume.Containers)+len(volume.ContainerJobs) > 0 {
+		return false, fmt.Errorf("volume %q is still mounted", volumeName)
+	}
+
+	delete(c.volumes, k)
+	return true, nil
+}
+
+func (c *Client) ListRegistries(ctx context.Context, namespace string) ([]api.RegistryResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "ListRegistries"); err != nil {
+		return []api.RegistryResult{}, err
+	}
+
+	return inNamespace(c.registries, namespace), nil
+}
+
+func (c *Client) ListRegistryByName(ctx context.Context, namespace string, registryName string) (*api.RegistryResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "ListRegistryByName"); err != nil {
+		return nil, err
+	}
+
+	registry, ok := c.registries[key{namespace, registryName}]
+	if !ok {
+		return nil, fmt.Errorf("registry %q in namespace %q: %w", registryName, namespace, ErrNotFound)
+	}
+	return &registry, nil
+}
+
+func (c *Client) RegistryCreate(ctx context.Context, input api.RegistryCreateInput) (api.RegistryResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "RegistryCreate"); err != nil {
+		return api.RegistryResult{}, err
+	}
+	if err := c.requireNamespace(input.Namespace); err != nil {
+		return api.RegistryResult{}, err
+	}
+	k := key{input.Namespace, input.Name}
+	if _, ok := c.registries[k]; ok {
+		return api.RegistryResult{}, fmt.Errorf("registry %q in namespace %q: %w", input.Name, input.Namespace, ErrExists)
+	}
+
+	registry := api.RegistryResult{Name: input.Name, Source: input.Source, Username: input.Username, State: StateCreated}
+	c.registries[k] = registry
+	return registry, nil
+}
+
+func (c *Client) RegistryDelete(ctx context.Context, namespace string, registryName string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail(ctx, "RegistryDelete"); err != nil {
+		return false, err
+	}
+	k := key{namespace, registryName}
+	if _, ok := c.registries[k]; !ok {
+		return false, fmt.Errorf("registry %q in namespace %q: %w", registryName, namespace, ErrNotFound)
+	}
+
+	delete(c.registries, k)
+	return true, nil
+}
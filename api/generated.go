@@ -5,6 +5,8 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
 )
@@ -21,6 +23,81 @@ func (v *AllowListInput) GetIp() string { return v.Ip }
 // GetState returns AllowListInput.State, and is useful for accessing the field via an interface.
 func (v *AllowListInput) GetState() State { return v.State }
 
+type AuditLogFilterInput struct {
+	EventTypes []string `json:"eventTypes"`
+	ModelNames []string `json:"modelNames"`
+}
+
+// GetEventTypes returns AuditLogFilterInput.EventTypes, and is useful for accessing the field via an interface.
+func (v *AuditLogFilterInput) GetEventTypes() []string { return v.EventTypes }
+
+// GetModelNames returns AuditLogFilterInput.ModelNames, and is useful for accessing the field via an interface.
+func (v *AuditLogFilterInput) GetModelNames() []string { return v.ModelNames }
+
+// AuditLogResult includes the GraphQL fields of AuditLog requested by the fragment AuditLogResult.
+type AuditLogResult struct {
+	Id         string                                  `json:"id"`
+	EventType  string                                  `json:"eventType"`
+	ModelId    int                                     `json:"modelId"`
+	ModelName  string                                  `json:"modelName"`
+	OccurredAt string                                  `json:"occurredAt"`
+	Tags       []string                                `json:"tags"`
+	Account    *AuditLogResultAccount                  `json:"account"`
+	ChangeSet  []AuditLogResultChangeSetAuditLogChange `json:"changeSet"`
+}
+
+// GetId returns AuditLogResult.Id, and is useful for accessing the field via an interface.
+func (v *AuditLogResult) GetId() string { return v.Id }
+
+// GetEventType returns AuditLogResult.EventType, and is useful for accessing the field via an interface.
+func (v *AuditLogResult) GetEventType() string { return v.EventType }
+
+// GetModelId returns AuditLogResult.ModelId, and is useful for accessing the field via an interface.
+func (v *AuditLogResult) GetModelId() int { return v.ModelId }
+
+// GetModelName returns AuditLogResult.ModelName, and is useful for accessing the field via an interface.
+func (v *AuditLogResult) GetModelName() string { return v.ModelName }
+
+// GetOccurredAt returns AuditLogResult.OccurredAt, and is useful for accessing the field via an interface.
+func (v *AuditLogResult) GetOccurredAt() string { return v.OccurredAt }
+
+// GetTags returns AuditLogResult.Tags, and is useful for accessing the field via an interface.
+func (v *AuditLogResult) GetTags() []string { return v.Tags }
+
+// GetAccount returns AuditLogResult.Account, and is useful for accessing the field via an interface.
+func (v *AuditLogResult) GetAccount() *AuditLogResultAccount { return v.Account }
+
+// GetChangeSet returns AuditLogResult.ChangeSet, and is useful for accessing the field via an interface.
+func (v *AuditLogResult) GetChangeSet() []AuditLogResultChangeSetAuditLogChange { return v.ChangeSet }
+
+// AuditLogResultAccount includes the requested fields of the GraphQL type Account.
+type AuditLogResultAccount struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// GetName returns AuditLogResultAccount.Name, and is useful for accessing the field via an interface.
+func (v *AuditLogResultAccount) GetName() string { return v.Name }
+
+// GetEmail returns AuditLogResultAccount.Email, and is useful for accessing the field via an interface.
+func (v *AuditLogResultAccount) GetEmail() string { return v.Email }
+
+// AuditLogResultChangeSetAuditLogChange includes the requested fields of the GraphQL type AuditLogChange.
+type AuditLogResultChangeSetAuditLogChange struct {
+	Name     string  `json:"name"`
+	OldValue *string `json:"oldValue"`
+	NewValue *string `json:"newValue"`
+}
+
+// GetName returns AuditLogResultChangeSetAuditLogChange.Name, and is useful for accessing the field via an interface.
+func (v *AuditLogResultChangeSetAuditLogChange) GetName() string { return v.Name }
+
+// GetOldValue returns AuditLogResultChangeSetAuditLogChange.OldValue, and is useful for accessing the field via an interface.
+func (v *AuditLogResultChangeSetAuditLogChange) GetOldValue() *string { return v.OldValue }
+
+// GetNewValue returns AuditLogResultChangeSetAuditLogChange.NewValue, and is useful for accessing the field via an interface.
+func (v *AuditLogResultChangeSetAuditLogChange) GetNewValue() *string { return v.NewValue }
+
 type AutoScalingInput struct {
 	Replicas ReplicasInput             `json:"replicas"`
 	Triggers []AutoScalingTriggerInput `json:"triggers"`
@@ -134,6 +211,83 @@ func (v *CloudDatabaseClusterDatabaseResult) GetDescription() *string { return v
 // GetStatus returns CloudDatabaseClusterDatabaseResult.Status, and is useful for accessing the field via an interface.
 func (v *CloudDatabaseClusterDatabaseResult) GetStatus() string { return v.Status }
 
+// CloudDatabaseClusterFeatureResult includes the GraphQL fields of CloudDatabaseClusterFeature requested by the fragment CloudDatabaseClusterFeatureResult.
+//
+// CloudDatabaseClusterFeatureResult is implemented by the following types:
+// CloudDatabaseClusterFeatureResultCloudDatabaseClusterFeatureImpl
+type CloudDatabaseClusterFeatureResult interface {
+	implementsGraphQLInterfaceCloudDatabaseClusterFeatureResult()
+	// GetEnabled returns the interface-field "enabled" from its implementation.
+	GetEnabled() bool
+	// GetStatus returns the interface-field "status" from its implementation.
+	GetStatus() string
+}
+
+func (v *CloudDatabaseClusterFeatureResultCloudDatabaseClusterFeatureImpl) implementsGraphQLInterfaceCloudDatabaseClusterFeatureResult() {
+}
+
+func __unmarshalCloudDatabaseClusterFeatureResult(b []byte, v *CloudDatabaseClusterFeatureResult) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CloudDatabaseClusterFeatureImpl":
+		*v = new(CloudDatabaseClusterFeatureResultCloudDatabaseClusterFeatureImpl)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing CloudDatabaseClusterFeature.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for CloudDatabaseClusterFeatureResult: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalCloudDatabaseClusterFeatureResult(v *CloudDatabaseClusterFeatureResult) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *CloudDatabaseClusterFeatureResultCloudDatabaseClusterFeatureImpl:
+		typename = "CloudDatabaseClusterFeatureImpl"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CloudDatabaseClusterFeatureResultCloudDatabaseClusterFeatureImpl
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for CloudDatabaseClusterFeatureResult: "%T"`, v)
+	}
+}
+
+// CloudDatabaseClusterFeatureResult includes the GraphQL fields of CloudDatabaseClusterFeatureImpl requested by the fragment CloudDatabaseClusterFeatureResult.
+type CloudDatabaseClusterFeatureResultCloudDatabaseClusterFeatureImpl struct {
+	Enabled bool   `json:"enabled"`
+	Status  string `json:"status"`
+}
+
+// GetEnabled returns CloudDatabaseClusterFeatureResultCloudDatabaseClusterFeatureImpl.Enabled, and is useful for accessing the field via an interface.
+func (v *CloudDatabaseClusterFeatureResultCloudDatabaseClusterFeatureImpl) GetEnabled() bool {
+	return v.Enabled
+}
+
+// GetStatus returns CloudDatabaseClusterFeatureResultCloudDatabaseClusterFeatureImpl.Status, and is useful for accessing the field via an interface.
+func (v *CloudDatabaseClusterFeatureResultCloudDatabaseClusterFeatureImpl) GetStatus() string {
+	return v.Status
+}
+
 // Input for create cloud database cluster.
 //
 // A database cluster is accessible from within the namespace your created it in.
@@ -224,6 +378,7 @@ type CloudDatabaseClusterResult struct {
 	Databases          []CloudDatabaseClusterResultDatabasesDatabase    `json:"databases"`
 	Name               string                                           `json:"name"`
 	Hostname           string                                           `json:"hostname"`
+	Port               int                                              `json:"port"`
 	Namespace          CloudDatabaseClusterResultNamespace              `json:"namespace"`
 	Plan               CloudDatabaseClusterResultPlan                   `json:"plan"`
 	Spec               CloudDatabaseClusterResultSpec                   `json:"spec"`
@@ -248,6 +403,9 @@ func (v *CloudDatabaseClusterResult) GetName() string { return v.Name }
 // GetHostname returns CloudDatabaseClusterResult.Hostname, and is useful for accessing the field via an interface.
 func (v *CloudDatabaseClusterResult) GetHostname() string { return v.Hostname }
 
+// GetPort returns CloudDatabaseClusterResult.Port, and is useful for accessing the field via an interface.
+func (v *CloudDatabaseClusterResult) GetPort() int { return v.Port }
+
 // GetNamespace returns CloudDatabaseClusterResult.Namespace, and is useful for accessing the field via an interface.
 func (v *CloudDatabaseClusterResult) GetNamespace() CloudDatabaseClusterResultNamespace {
 	return v.Namespace
@@ -1970,12 +2128,24 @@ func (v *MessageQueueCreateInput) GetExternalConnection() *ExternalConnectionInp
 
 // MessageQueueIngressResult includes the GraphQL fields of MessageQueueIngress requested by the fragment MessageQueueIngressResult.
 type MessageQueueIngressResult struct {
-	AllowList []string `json:"allowList"`
+	AllowList  []string `json:"allowList"`
+	DomainName string   `json:"domainName"`
+	Status     Status   `json:"status"`
+	TlsEnabled bool     `json:"tlsEnabled"`
 }
 
 // GetAllowList returns MessageQueueIngressResult.AllowList, and is useful for accessing the field via an interface.
 func (v *MessageQueueIngressResult) GetAllowList() []string { return v.AllowList }
 
+// GetDomainName returns MessageQueueIngressResult.DomainName, and is useful for accessing the field via an interface.
+func (v *MessageQueueIngressResult) GetDomainName() string { return v.DomainName }
+
+// GetStatus returns MessageQueueIngressResult.Status, and is useful for accessing the field via an interface.
+func (v *MessageQueueIngressResult) GetStatus() Status { return v.Status }
+
+// GetTlsEnabled returns MessageQueueIngressResult.TlsEnabled, and is useful for accessing the field via an interface.
+func (v *MessageQueueIngressResult) GetTlsEnabled() bool { return v.TlsEnabled }
+
 type MessageQueueModifyInput struct {
 	Name               string                   `json:"name"`
 	Namespace          string                   `json:"namespace"`
@@ -1999,14 +2169,15 @@ func (v *MessageQueueModifyInput) GetExternalConnection() *ExternalConnectionInp
 
 // MessageQueuePlanResult includes the GraphQL fields of MessageQueuePlan requested by the fragment MessageQueuePlanResult.
 type MessageQueuePlanResult struct {
-	Cpu      float64                     `json:"cpu"`
-	Group    string                      `json:"group"`
-	Id       string                      `json:"id"`
-	Memory   float64                     `json:"memory"`
-	Name     string                      `json:"name"`
-	Price    MessageQueuePlanResultPrice `json:"price"`
-	Replicas int                         `json:"replicas"`
-	Storage  float64                     `json:"storage"`
+	Cpu       float64                                                   `json:"cpu"`
+	Group     string                                                    `json:"group"`
+	Id        string                                                    `json:"id"`
+	Memory    float64                                                   `json:"memory"`
+	Name      string                                                    `json:"name"`
+	Price     MessageQueuePlanResultPrice                               `json:"price"`
+	Replicas  int                                                       `json:"replicas"`
+	Storage   float64                                                   `json:"storage"`
+	Benchmark *MessageQueuePlanResultBenchmarkMessageQueuePlanBenchmark `json:"benchmark"`
 }
 
 // GetCpu returns MessageQueuePlanResult.Cpu, and is useful for accessing the field via an interface.
@@ -2033,6 +2204,33 @@ func (v *MessageQueuePlanResult) GetReplicas() int { return v.Replicas }
 // GetStorage returns MessageQueuePlanResult.Storage, and is useful for accessing the field via an interface.
 func (v *MessageQueuePlanResult) GetStorage() float64 { return v.Storage }
 
+// GetBenchmark returns MessageQueuePlanResult.Benchmark, and is useful for accessing the field via an interface.
+func (v *MessageQueuePlanResult) GetBenchmark() *MessageQueuePlanResultBenchmarkMessageQueuePlanBenchmark {
+	return v.Benchmark
+}
+
+// MessageQueuePlanResultBenchmarkMessageQueuePlanBenchmark includes the requested fields of the GraphQL type MessageQueuePlanBenchmark.
+type MessageQueuePlanResultBenchmarkMessageQueuePlanBenchmark struct {
+	Connections        int `json:"connections"`
+	MaximumMessageRate int `json:"maximumMessageRate"`
+	MinimumMessageRate int `json:"minimumMessageRate"`
+}
+
+// GetConnections returns MessageQueuePlanResultBenchmarkMessageQueuePlanBenchmark.Connections, and is useful for accessing the field via an interface.
+func (v *MessageQueuePlanResultBenchmarkMessageQueuePlanBenchmark) GetConnections() int {
+	return v.Connections
+}
+
+// GetMaximumMessageRate returns MessageQueuePlanResultBenchmarkMessageQueuePlanBenchmark.MaximumMessageRate, and is useful for accessing the field via an interface.
+func (v *MessageQueuePlanResultBenchmarkMessageQueuePlanBenchmark) GetMaximumMessageRate() int {
+	return v.MaximumMessageRate
+}
+
+// GetMinimumMessageRate returns MessageQueuePlanResultBenchmarkMessageQueuePlanBenchmark.MinimumMessageRate, and is useful for accessing the field via an interface.
+func (v *MessageQueuePlanResultBenchmarkMessageQueuePlanBenchmark) GetMinimumMessageRate() int {
+	return v.MinimumMessageRate
+}
+
 // MessageQueuePlanResultPrice includes the requested fields of the GraphQL type Price.
 type MessageQueuePlanResultPrice struct {
 	Amount   *int    `json:"amount"`
@@ -2202,6 +2400,21 @@ func (v *MessageQueueResultIngressMessageQueueIngress) GetAllowList() []string {
 	return v.MessageQueueIngressResult.AllowList
 }
 
+// GetDomainName returns MessageQueueResultIngressMessageQueueIngress.DomainName, and is useful for accessing the field via an interface.
+func (v *MessageQueueResultIngressMessageQueueIngress) GetDomainName() string {
+	return v.MessageQueueIngressResult.DomainName
+}
+
+// GetStatus returns MessageQueueResultIngressMessageQueueIngress.Status, and is useful for accessing the field via an interface.
+func (v *MessageQueueResultIngressMessageQueueIngress) GetStatus() Status {
+	return v.MessageQueueIngressResult.Status
+}
+
+// GetTlsEnabled returns MessageQueueResultIngressMessageQueueIngress.TlsEnabled, and is useful for accessing the field via an interface.
+func (v *MessageQueueResultIngressMessageQueueIngress) GetTlsEnabled() bool {
+	return v.MessageQueueIngressResult.TlsEnabled
+}
+
 func (v *MessageQueueResultIngressMessageQueueIngress) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -2229,6 +2442,12 @@ func (v *MessageQueueResultIngressMessageQueueIngress) UnmarshalJSON(b []byte) e
 
 type __premarshalMessageQueueResultIngressMessageQueueIngress struct {
 	AllowList []string `json:"allowList"`
+
+	DomainName string `json:"domainName"`
+
+	Status Status `json:"status"`
+
+	TlsEnabled bool `json:"tlsEnabled"`
 }
 
 func (v *MessageQueueResultIngressMessageQueueIngress) MarshalJSON() ([]byte, error) {
@@ -2243,6 +2462,9 @@ func (v *MessageQueueResultIngressMessageQueueIngress) __premarshalJSON() (*__pr
 	var retval __premarshalMessageQueueResultIngressMessageQueueIngress
 
 	retval.AllowList = v.MessageQueueIngressResult.AllowList
+	retval.DomainName = v.MessageQueueIngressResult.DomainName
+	retval.Status = v.MessageQueueIngressResult.Status
+	retval.TlsEnabled = v.MessageQueueIngressResult.TlsEnabled
 	return &retval, nil
 }
 
@@ -2297,6 +2519,11 @@ func (v *MessageQueueResultPlanMessageQueuePlan) GetStorage() float64 {
 	return v.MessageQueuePlanResult.Storage
 }
 
+// GetBenchmark returns MessageQueueResultPlanMessageQueuePlan.Benchmark, and is useful for accessing the field via an interface.
+func (v *MessageQueueResultPlanMessageQueuePlan) GetBenchmark() *MessageQueuePlanResultBenchmarkMessageQueuePlanBenchmark {
+	return v.MessageQueuePlanResult.Benchmark
+}
+
 func (v *MessageQueueResultPlanMessageQueuePlan) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -2338,6 +2565,8 @@ type __premarshalMessageQueueResultPlanMessageQueuePlan struct {
 	Replicas int `json:"replicas"`
 
 	Storage float64 `json:"storage"`
+
+	Benchmark *MessageQueuePlanResultBenchmarkMessageQueuePlanBenchmark `json:"benchmark"`
 }
 
 func (v *MessageQueueResultPlanMessageQueuePlan) MarshalJSON() ([]byte, error) {
@@ -2359,6 +2588,7 @@ func (v *MessageQueueResultPlanMessageQueuePlan) __premarshalJSON() (*__premarsh
 	retval.Price = v.MessageQueuePlanResult.Price
 	retval.Replicas = v.MessageQueuePlanResult.Replicas
 	retval.Storage = v.MessageQueuePlanResult.Storage
+	retval.Benchmark = v.MessageQueuePlanResult.Benchmark
 	return &retval, nil
 }
 
@@ -2535,6 +2765,214 @@ func (v *NamespaceCreateInput) GetName() string { return v.Name }
 // GetDescription returns NamespaceCreateInput.Description, and is useful for accessing the field via an interface.
 func (v *NamespaceCreateInput) GetDescription() *string { return v.Description }
 
+// NamespaceDescription includes the GraphQL fields of Namespace requested by the fragment NamespaceDescription.
+type NamespaceDescription struct {
+	Name                  string                                                          `json:"name"`
+	Description           string                                                          `json:"description"`
+	State                 string                                                          `json:"state"`
+	Containers            []NamespaceDescriptionContainersContainer                       `json:"containers"`
+	ContainerJobs         []NamespaceDescriptionContainerJobsContainerJob                 `json:"containerJobs"`
+	Volumes               []NamespaceDescriptionVolumesVolume                             `json:"volumes"`
+	CloudDatabaseClusters []NamespaceDescriptionCloudDatabaseClustersCloudDatabaseCluster `json:"cloudDatabaseClusters"`
+	MessageQueues         []NamespaceDescriptionMessageQueuesMessageQueue                 `json:"messageQueues"`
+	PrivateRegistries     []NamespaceDescriptionPrivateRegistriesPrivateRegistry          `json:"privateRegistries"`
+}
+
+// GetName returns NamespaceDescription.Name, and is useful for accessing the field via an interface.
+func (v *NamespaceDescription) GetName() string { return v.Name }
+
+// GetDescription returns NamespaceDescription.Description, and is useful for accessing the field via an interface.
+func (v *NamespaceDescription) GetDescription() string { return v.Description }
+
+// GetState returns NamespaceDescription.State, and is useful for accessing the field via an interface.
+func (v *NamespaceDescription) GetState() string { return v.State }
+
+// GetContainers returns NamespaceDescription.Containers, and is useful for accessing the field via an interface.
+func (v *NamespaceDescription) GetContainers() []NamespaceDescriptionContainersContainer {
+	return v.Containers
+}
+
+// GetContainerJobs returns NamespaceDescription.ContainerJobs, and is useful for accessing the field via an interface.
+func (v *NamespaceDescription) GetContainerJobs() []NamespaceDescriptionContainerJobsContainerJob {
+	return v.ContainerJobs
+}
+
+// GetVolumes returns NamespaceDescription.Volumes, and is useful for accessing the field via an interface.
+func (v *NamespaceDescription) GetVolumes() []NamespaceDescriptionVolumesVolume { return v.Volumes }
+
+// GetCloudDatabaseClusters returns NamespaceDescription.CloudDatabaseClusters, and is useful for accessing the field via an interface.
+func (v *NamespaceDescription) GetCloudDatabaseClusters() []NamespaceDescriptionCloudDatabaseClustersCloudDatabaseCluster {
+	return v.CloudDatabaseClusters
+}
+
+// GetMessageQueues returns NamespaceDescription.MessageQueues, and is useful for accessing the field via an interface.
+func (v *NamespaceDescription) GetMessageQueues() []NamespaceDescriptionMessageQueuesMessageQueue {
+	return v.MessageQueues
+}
+
+// GetPrivateRegistries returns NamespaceDescription.PrivateRegistries, and is useful for accessing the field via an interface.
+func (v *NamespaceDescription) GetPrivateRegistries() []NamespaceDescriptionPrivateRegistriesPrivateRegistry {
+	return v.PrivateRegistries
+}
+
+// NamespaceDescriptionCloudDatabaseClustersCloudDatabaseCluster includes the requested fields of the GraphQL type CloudDatabaseCluster.
+type NamespaceDescriptionCloudDatabaseClustersCloudDatabaseCluster struct {
+	Name   string `json:"name"`
+	State  string `json:"state"`
+	Locked bool   `json:"locked"`
+}
+
+// GetName returns NamespaceDescriptionCloudDatabaseClustersCloudDatabaseCluster.Name, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionCloudDatabaseClustersCloudDatabaseCluster) GetName() string {
+	return v.Name
+}
+
+// GetState returns NamespaceDescriptionCloudDatabaseClustersCloudDatabaseCluster.State, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionCloudDatabaseClustersCloudDatabaseCluster) GetState() string {
+	return v.State
+}
+
+// GetLocked returns NamespaceDescriptionCloudDatabaseClustersCloudDatabaseCluster.Locked, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionCloudDatabaseClustersCloudDatabaseCluster) GetLocked() bool {
+	return v.Locked
+}
+
+// NamespaceDescriptionContainerJobsContainerJob includes the requested fields of the GraphQL type ContainerJob.
+type NamespaceDescriptionContainerJobsContainerJob struct {
+	Name     string                                                             `json:"name"`
+	State    string                                                             `json:"state"`
+	Locked   bool                                                               `json:"locked"`
+	Enabled  bool                                                               `json:"enabled"`
+	Schedule string                                                             `json:"schedule"`
+	Runs     []NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun `json:"runs"`
+}
+
+// GetName returns NamespaceDescriptionContainerJobsContainerJob.Name, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionContainerJobsContainerJob) GetName() string { return v.Name }
+
+// GetState returns NamespaceDescriptionContainerJobsContainerJob.State, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionContainerJobsContainerJob) GetState() string { return v.State }
+
+// GetLocked returns NamespaceDescriptionContainerJobsContainerJob.Locked, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionContainerJobsContainerJob) GetLocked() bool { return v.Locked }
+
+// GetEnabled returns NamespaceDescriptionContainerJobsContainerJob.Enabled, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionContainerJobsContainerJob) GetEnabled() bool { return v.Enabled }
+
+// GetSchedule returns NamespaceDescriptionContainerJobsContainerJob.Schedule, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionContainerJobsContainerJob) GetSchedule() string { return v.Schedule }
+
+// GetRuns returns NamespaceDescriptionContainerJobsContainerJob.Runs, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionContainerJobsContainerJob) GetRuns() []NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun {
+	return v.Runs
+}
+
+// NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun includes the requested fields of the GraphQL type ContainerJobRun.
+type NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun struct {
+	Name      string     `json:"name"`
+	Status    string     `json:"status"`
+	StartTime *time.Time `json:"startTime"`
+}
+
+// GetName returns NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun.Name, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun) GetName() string {
+	return v.Name
+}
+
+// GetStatus returns NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun.Status, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun) GetStatus() string {
+	return v.Status
+}
+
+// GetStartTime returns NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun.StartTime, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun) GetStartTime() *time.Time {
+	return v.StartTime
+}
+
+// NamespaceDescriptionContainersContainer includes the requested fields of the GraphQL type Container.
+type NamespaceDescriptionContainersContainer struct {
+	Name              string `json:"name"`
+	State             string `json:"state"`
+	Locked            bool   `json:"locked"`
+	AvailableReplicas int    `json:"availableReplicas"`
+	NumberOfReplicas  int    `json:"numberOfReplicas"`
+}
+
+// GetName returns NamespaceDescriptionContainersContainer.Name, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionContainersContainer) GetName() string { return v.Name }
+
+// GetState returns NamespaceDescriptionContainersContainer.State, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionContainersContainer) GetState() string { return v.State }
+
+// GetLocked returns NamespaceDescriptionContainersContainer.Locked, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionContainersContainer) GetLocked() bool { return v.Locked }
+
+// GetAvailableReplicas returns NamespaceDescriptionContainersContainer.AvailableReplicas, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionContainersContainer) GetAvailableReplicas() int {
+	return v.AvailableReplicas
+}
+
+// GetNumberOfReplicas returns NamespaceDescriptionContainersContainer.NumberOfReplicas, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionContainersContainer) GetNumberOfReplicas() int {
+	return v.NumberOfReplicas
+}
+
+// NamespaceDescriptionMessageQueuesMessageQueue includes the requested fields of the GraphQL type MessageQueue.
+type NamespaceDescriptionMessageQueuesMessageQueue struct {
+	Name   string `json:"name"`
+	State  string `json:"state"`
+	Locked bool   `json:"locked"`
+}
+
+// GetName returns NamespaceDescriptionMessageQueuesMessageQueue.Name, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionMessageQueuesMessageQueue) GetName() string { return v.Name }
+
+// GetState returns NamespaceDescriptionMessageQueuesMessageQueue.State, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionMessageQueuesMessageQueue) GetState() string { return v.State }
+
+// GetLocked returns NamespaceDescriptionMessageQueuesMessageQueue.Locked, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionMessageQueuesMessageQueue) GetLocked() bool { return v.Locked }
+
+// NamespaceDescriptionPrivateRegistriesPrivateRegistry includes the requested fields of the GraphQL type PrivateRegistry.
+type NamespaceDescriptionPrivateRegistriesPrivateRegistry struct {
+	Name   string `json:"name"`
+	State  string `json:"state"`
+	Locked bool   `json:"locked"`
+}
+
+// GetName returns NamespaceDescriptionPrivateRegistriesPrivateRegistry.Name, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionPrivateRegistriesPrivateRegistry) GetName() string { return v.Name }
+
+// GetState returns NamespaceDescriptionPrivateRegistriesPrivateRegistry.State, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionPrivateRegistriesPrivateRegistry) GetState() string { return v.State }
+
+// GetLocked returns NamespaceDescriptionPrivateRegistriesPrivateRegistry.Locked, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionPrivateRegistriesPrivateRegistry) GetLocked() bool { return v.Locked }
+
+// NamespaceDescriptionVolumesVolume includes the requested fields of the GraphQL type Volume.
+type NamespaceDescriptionVolumesVolume struct {
+	Name   string  `json:"name"`
+	State  string  `json:"state"`
+	Locked bool    `json:"locked"`
+	Size   float64 `json:"size"`
+	Usage  float64 `json:"usage"`
+}
+
+// GetName returns NamespaceDescriptionVolumesVolume.Name, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionVolumesVolume) GetName() string { return v.Name }
+
+// GetState returns NamespaceDescriptionVolumesVolume.State, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionVolumesVolume) GetState() string { return v.State }
+
+// GetLocked returns NamespaceDescriptionVolumesVolume.Locked, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionVolumesVolume) GetLocked() bool { return v.Locked }
+
+// GetSize returns NamespaceDescriptionVolumesVolume.Size, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionVolumesVolume) GetSize() float64 { return v.Size }
+
+// GetUsage returns NamespaceDescriptionVolumesVolume.Usage, and is useful for accessing the field via an interface.
+func (v *NamespaceDescriptionVolumesVolume) GetUsage() float64 { return v.Usage }
+
 // NamespaceResult includes the GraphQL fields of Namespace requested by the fragment NamespaceResult.
 type NamespaceResult struct {
 	Name                  string                                                     `json:"name"`
@@ -2545,6 +2983,7 @@ type NamespaceResult struct {
 	Volumes               []NamespaceResultVolumesVolume                             `json:"volumes"`
 	CloudDatabaseClusters []NamespaceResultCloudDatabaseClustersCloudDatabaseCluster `json:"cloudDatabaseClusters"`
 	MessageQueues         []NamespaceResultMessageQueuesMessageQueue                 `json:"messageQueues"`
+	PrivateRegistries     []NamespaceResultPrivateRegistriesPrivateRegistry          `json:"privateRegistries"`
 }
 
 // GetName returns NamespaceResult.Name, and is useful for accessing the field via an interface.
@@ -2577,6 +3016,11 @@ func (v *NamespaceResult) GetMessageQueues() []NamespaceResultMessageQueuesMessa
 	return v.MessageQueues
 }
 
+// GetPrivateRegistries returns NamespaceResult.PrivateRegistries, and is useful for accessing the field via an interface.
+func (v *NamespaceResult) GetPrivateRegistries() []NamespaceResultPrivateRegistriesPrivateRegistry {
+	return v.PrivateRegistries
+}
+
 // NamespaceResultCloudDatabaseClustersCloudDatabaseCluster includes the requested fields of the GraphQL type CloudDatabaseCluster.
 type NamespaceResultCloudDatabaseClustersCloudDatabaseCluster struct {
 	Name string `json:"name"`
@@ -2609,6 +3053,14 @@ type NamespaceResultMessageQueuesMessageQueue struct {
 // GetName returns NamespaceResultMessageQueuesMessageQueue.Name, and is useful for accessing the field via an interface.
 func (v *NamespaceResultMessageQueuesMessageQueue) GetName() string { return v.Name }
 
+// NamespaceResultPrivateRegistriesPrivateRegistry includes the requested fields of the GraphQL type PrivateRegistry.
+type NamespaceResultPrivateRegistriesPrivateRegistry struct {
+	Name string `json:"name"`
+}
+
+// GetName returns NamespaceResultPrivateRegistriesPrivateRegistry.Name, and is useful for accessing the field via an interface.
+func (v *NamespaceResultPrivateRegistriesPrivateRegistry) GetName() string { return v.Name }
+
 // NamespaceResultVolumesVolume includes the requested fields of the GraphQL type Volume.
 type NamespaceResultVolumesVolume struct {
 	Name string `json:"name"`
@@ -2658,11 +3110,12 @@ func (v *RegistryCreateInput) GetVerify() bool { return v.Verify }
 
 // RegistryResult includes the GraphQL fields of PrivateRegistry requested by the fragment RegistryResult.
 type RegistryResult struct {
-	Name     string `json:"name"`
-	Source   string `json:"source"`
-	Username string `json:"username"`
-	State    string `json:"state"`
-	Locked   bool   `json:"locked"`
+	Name      string `json:"name"`
+	Source    string `json:"source"`
+	Username  string `json:"username"`
+	State     string `json:"state"`
+	Locked    bool   `json:"locked"`
+	CreatedAt string `json:"createdAt"`
 }
 
 // GetName returns RegistryResult.Name, and is useful for accessing the field via an interface.
@@ -2680,6 +3133,9 @@ func (v *RegistryResult) GetState() string { return v.State }
 // GetLocked returns RegistryResult.Locked, and is useful for accessing the field via an interface.
 func (v *RegistryResult) GetLocked() bool { return v.Locked }
 
+// GetCreatedAt returns RegistryResult.CreatedAt, and is useful for accessing the field via an interface.
+func (v *RegistryResult) GetCreatedAt() string { return v.CreatedAt }
+
 type ReplicasInput struct {
 	Minimum int `json:"minimum"`
 	Maximum int `json:"maximum"`
@@ -2691,6 +3147,42 @@ func (v *ReplicasInput) GetMinimum() int { return v.Minimum }
 // GetMaximum returns ReplicasInput.Maximum, and is useful for accessing the field via an interface.
 func (v *ReplicasInput) GetMaximum() int { return v.Maximum }
 
+// ResourceSpecificationResult includes the GraphQL fields of ResourceSpecification requested by the fragment ResourceSpecificationResult.
+type ResourceSpecificationResult struct {
+	Id    string                           `json:"id"`
+	Kind  string                           `json:"kind"`
+	Cpu   float64                          `json:"cpu"`
+	Ram   float64                          `json:"ram"`
+	Price ResourceSpecificationResultPrice `json:"price"`
+}
+
+// GetId returns ResourceSpecificationResult.Id, and is useful for accessing the field via an interface.
+func (v *ResourceSpecificationResult) GetId() string { return v.Id }
+
+// GetKind returns ResourceSpecificationResult.Kind, and is useful for accessing the field via an interface.
+func (v *ResourceSpecificationResult) GetKind() string { return v.Kind }
+
+// GetCpu returns ResourceSpecificationResult.Cpu, and is useful for accessing the field via an interface.
+func (v *ResourceSpecificationResult) GetCpu() float64 { return v.Cpu }
+
+// GetRam returns ResourceSpecificationResult.Ram, and is useful for accessing the field via an interface.
+func (v *ResourceSpecificationResult) GetRam() float64 { return v.Ram }
+
+// GetPrice returns ResourceSpecificationResult.Price, and is useful for accessing the field via an interface.
+func (v *ResourceSpecificationResult) GetPrice() ResourceSpecificationResultPrice { return v.Price }
+
+// ResourceSpecificationResultPrice includes the requested fields of the GraphQL type Price.
+type ResourceSpecificationResultPrice struct {
+	Amount   *int    `json:"amount"`
+	Currency *string `json:"currency"`
+}
+
+// GetAmount returns ResourceSpecificationResultPrice.Amount, and is useful for accessing the field via an interface.
+func (v *ResourceSpecificationResultPrice) GetAmount() *int { return v.Amount }
+
+// GetCurrency returns ResourceSpecificationResultPrice.Currency, and is useful for accessing the field via an interface.
+func (v *ResourceSpecificationResultPrice) GetCurrency() *string { return v.Currency }
+
 type ScalingInput struct {
 	Auto   *AutoScalingInput   `json:"auto"`
 	Manual *ManualScalingInput `json:"manual"`
@@ -2714,6 +3206,36 @@ var AllState = []State{
 	StateAbsent,
 }
 
+type Status string
+
+const (
+	StatusUnprovisioned   Status = "UNPROVISIONED"
+	StatusCreateRequested Status = "CREATE_REQUESTED"
+	StatusCreating        Status = "CREATING"
+	StatusCreated         Status = "CREATED"
+	StatusUpdating        Status = "UPDATING"
+	StatusUpdated         Status = "UPDATED"
+	StatusOnHold          Status = "ON_HOLD"
+	StatusFailed          Status = "FAILED"
+	StatusDeletePending   Status = "DELETE_PENDING"
+	StatusDeleteRequested Status = "DELETE_REQUESTED"
+	StatusDeleting        Status = "DELETING"
+)
+
+var AllStatus = []Status{
+	StatusUnprovisioned,
+	StatusCreateRequested,
+	StatusCreating,
+	StatusCreated,
+	StatusUpdating,
+	StatusUpdated,
+	StatusOnHold,
+	StatusFailed,
+	StatusDeletePending,
+	StatusDeleteRequested,
+	StatusDeleting,
+}
+
 type VolumeCreateInput struct {
 	Name      string `json:"name"`
 	Namespace string `json:"namespace"`
@@ -2794,6 +3316,18 @@ type VolumeResultContainersContainer struct {
 // GetName returns VolumeResultContainersContainer.Name, and is useful for accessing the field via an interface.
 func (v *VolumeResultContainersContainer) GetName() string { return v.Name }
 
+// __auditLogsInput is used internally by genqlient
+type __auditLogsInput struct {
+	CustomerId string               `json:"customerId"`
+	Filter     *AuditLogFilterInput `json:"filter"`
+}
+
+// GetCustomerId returns __auditLogsInput.CustomerId, and is useful for accessing the field via an interface.
+func (v *__auditLogsInput) GetCustomerId() string { return v.CustomerId }
+
+// GetFilter returns __auditLogsInput.Filter, and is useful for accessing the field via an interface.
+func (v *__auditLogsInput) GetFilter() *AuditLogFilterInput { return v.Filter }
+
 // __cloudDatabaseClusterCreateInput is used internally by genqlient
 type __cloudDatabaseClusterCreateInput struct {
 	CloudDatabaseClusterInput CloudDatabaseClusterCreateInput `json:"cloudDatabaseClusterInput"`
@@ -2990,6 +3524,16 @@ func (v *__getCloudDatabaseClusterDatabasesInput) GetCloudDatabaseCluster() Clou
 	return v.CloudDatabaseCluster
 }
 
+// __getCloudDatabaseClusterFeaturesInput is used internally by genqlient
+type __getCloudDatabaseClusterFeaturesInput struct {
+	CloudDatabaseClusterInput CloudDatabaseClusterResourceInput `json:"cloudDatabaseClusterInput"`
+}
+
+// GetCloudDatabaseClusterInput returns __getCloudDatabaseClusterFeaturesInput.CloudDatabaseClusterInput, and is useful for accessing the field via an interface.
+func (v *__getCloudDatabaseClusterFeaturesInput) GetCloudDatabaseClusterInput() CloudDatabaseClusterResourceInput {
+	return v.CloudDatabaseClusterInput
+}
+
 // __getCloudDatabaseClusterInput is used internally by genqlient
 type __getCloudDatabaseClusterInput struct {
 	CloudDatabaseClusterInput CloudDatabaseClusterResourceInput `json:"cloudDatabaseClusterInput"`
@@ -3078,6 +3622,16 @@ func (v *__messageQueueUserCredentialsGetInput) GetMessageQueueInput() MessageQu
 // GetUsername returns __messageQueueUserCredentialsGetInput.Username, and is useful for accessing the field via an interface.
 func (v *__messageQueueUserCredentialsGetInput) GetUsername() string { return v.Username }
 
+// __messageQueueUserModifyInput is used internally by genqlient
+type __messageQueueUserModifyInput struct {
+	UserInput CloudDatabaseClusterUserModifyInput `json:"userInput"`
+}
+
+// GetUserInput returns __messageQueueUserModifyInput.UserInput, and is useful for accessing the field via an interface.
+func (v *__messageQueueUserModifyInput) GetUserInput() CloudDatabaseClusterUserModifyInput {
+	return v.UserInput
+}
+
 // __modifyCloudDatabaseClusterUserInput is used internally by genqlient
 type __modifyCloudDatabaseClusterUserInput struct {
 	UserInput CloudDatabaseClusterUserModifyInput `json:"userInput"`
@@ -3104,6 +3658,14 @@ type __namespaceDeleteInput struct {
 // GetName returns __namespaceDeleteInput.Name, and is useful for accessing the field via an interface.
 func (v *__namespaceDeleteInput) GetName() string { return v.Name }
 
+// __namespaceDescribeInput is used internally by genqlient
+type __namespaceDescribeInput struct {
+	Name string `json:"name"`
+}
+
+// GetName returns __namespaceDescribeInput.Name, and is useful for accessing the field via an interface.
+func (v *__namespaceDescribeInput) GetName() string { return v.Name }
+
 // __namespaceListByNameInput is used internally by genqlient
 type __namespaceListByNameInput struct {
 	Name string `json:"name"`
@@ -3140,6 +3702,14 @@ type __registryListInput struct {
 // GetNamespaceName returns __registryListInput.NamespaceName, and is useful for accessing the field via an interface.
 func (v *__registryListInput) GetNamespaceName() string { return v.NamespaceName }
 
+// __resourceSpecificationsInput is used internally by genqlient
+type __resourceSpecificationsInput struct {
+	Kind string `json:"kind"`
+}
+
+// GetKind returns __resourceSpecificationsInput.Kind, and is useful for accessing the field via an interface.
+func (v *__resourceSpecificationsInput) GetKind() string { return v.Kind }
+
 // __volumeCreateInput is used internally by genqlient
 type __volumeCreateInput struct {
 	Input VolumeCreateInput `json:"input"`
@@ -3176,6 +3746,43 @@ type __volumeListInput struct {
 // GetNamespaceName returns __volumeListInput.NamespaceName, and is useful for accessing the field via an interface.
 func (v *__volumeListInput) GetNamespaceName() string { return v.NamespaceName }
 
+// accountAccount includes the requested fields of the GraphQL type Account.
+type accountAccount struct {
+	Customer accountAccountCustomer `json:"customer"`
+}
+
+// GetCustomer returns accountAccount.Customer, and is useful for accessing the field via an interface.
+func (v *accountAccount) GetCustomer() accountAccountCustomer { return v.Customer }
+
+// accountAccountCustomer includes the requested fields of the GraphQL type Customer.
+type accountAccountCustomer struct {
+	Id string `json:"id"`
+}
+
+// GetId returns accountAccountCustomer.Id, and is useful for accessing the field via an interface.
+func (v *accountAccountCustomer) GetId() string { return v.Id }
+
+// accountResponse is returned by account on success.
+type accountResponse struct {
+	// Returns the current user account.
+	// This query will return the account of the currents api user.
+	//
+	// Cost: complexity = 100, multipliers = [], defaultMultiplier = null
+	Account *accountAccount `json:"account"`
+}
+
+// GetAccount returns accountResponse.Account, and is useful for accessing the field via an interface.
+func (v *accountResponse) GetAccount() *accountAccount { return v.Account }
+
+// auditLogsResponse is returned by auditLogs on success.
+type auditLogsResponse struct {
+	// Cost: complexity = 100, multipliers = [], defaultMultiplier = null
+	AuditLogs []*AuditLogResult `json:"auditLogs"`
+}
+
+// GetAuditLogs returns auditLogsResponse.AuditLogs, and is useful for accessing the field via an interface.
+func (v *auditLogsResponse) GetAuditLogs() []*AuditLogResult { return v.AuditLogs }
+
 // cloudDatabaseClusterCreateResponse is returned by cloudDatabaseClusterCreate on success.
 type cloudDatabaseClusterCreateResponse struct {
 	// Cost: complexity = 100, multipliers = [], defaultMultiplier = null
@@ -3998,35 +4605,261 @@ func (v *getCloudDatabaseClusterDatabasesResponse) GetCloudDatabaseCluster() get
 	return v.CloudDatabaseCluster
 }
 
-// getCloudDatabaseClusterResponse is returned by getCloudDatabaseCluster on success.
-type getCloudDatabaseClusterResponse struct {
-	// Cost: complexity = 100, multipliers = [], defaultMultiplier = null
-	CloudDatabaseCluster CloudDatabaseClusterResult `json:"cloudDatabaseCluster"`
+// getCloudDatabaseClusterFeaturesCloudDatabaseCluster includes the requested fields of the GraphQL type CloudDatabaseCluster.
+type getCloudDatabaseClusterFeaturesCloudDatabaseCluster struct {
+	// Returns the features available on a cluster.
+	// Features are extra functionality or capabilities for database clusters.
+	// They are opt-in and provide extra services for your database.
+	//
+	// Cost: complexity = 10, multipliers = [], defaultMultiplier = null
+	Features []getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeature `json:"-"`
 }
 
-// GetCloudDatabaseCluster returns getCloudDatabaseClusterResponse.CloudDatabaseCluster, and is useful for accessing the field via an interface.
-func (v *getCloudDatabaseClusterResponse) GetCloudDatabaseCluster() CloudDatabaseClusterResult {
-	return v.CloudDatabaseCluster
+// GetFeatures returns getCloudDatabaseClusterFeaturesCloudDatabaseCluster.Features, and is useful for accessing the field via an interface.
+func (v *getCloudDatabaseClusterFeaturesCloudDatabaseCluster) GetFeatures() []getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeature {
+	return v.Features
 }
 
-// getCloudDatabaseClusterUserCredentialsCloudDatabaseClusterUserCredentialsDatabaseUser includes the requested fields of the GraphQL type DatabaseUser.
-type getCloudDatabaseClusterUserCredentialsCloudDatabaseClusterUserCredentialsDatabaseUser struct {
-	Dsn string `json:"dsn"`
-}
+func (v *getCloudDatabaseClusterFeaturesCloudDatabaseCluster) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
 
-// GetDsn returns getCloudDatabaseClusterUserCredentialsCloudDatabaseClusterUserCredentialsDatabaseUser.Dsn, and is useful for accessing the field via an interface.
-func (v *getCloudDatabaseClusterUserCredentialsCloudDatabaseClusterUserCredentialsDatabaseUser) GetDsn() string {
-	return v.Dsn
+	var firstPass struct {
+		*getCloudDatabaseClusterFeaturesCloudDatabaseCluster
+		Features []json.RawMessage `json:"features"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getCloudDatabaseClusterFeaturesCloudDatabaseCluster = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Features
+		src := firstPass.Features
+		*dst = make(
+			[]getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeature,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalgetCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeature(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"unable to unmarshal getCloudDatabaseClusterFeaturesCloudDatabaseCluster.Features: %w", err)
+				}
+			}
+		}
+	}
+	return nil
 }
 
-// getCloudDatabaseClusterUserCredentialsResponse is returned by getCloudDatabaseClusterUserCredentials on success.
-type getCloudDatabaseClusterUserCredentialsResponse struct {
-	// Cost: complexity = 750, multipliers = [], defaultMultiplier = null
-	CloudDatabaseClusterUserCredentials getCloudDatabaseClusterUserCredentialsCloudDatabaseClusterUserCredentialsDatabaseUser `json:"cloudDatabaseClusterUserCredentials"`
+type __premarshalgetCloudDatabaseClusterFeaturesCloudDatabaseCluster struct {
+	Features []json.RawMessage `json:"features"`
 }
 
-// GetCloudDatabaseClusterUserCredentials returns getCloudDatabaseClusterUserCredentialsResponse.CloudDatabaseClusterUserCredentials, and is useful for accessing the field via an interface.
-func (v *getCloudDatabaseClusterUserCredentialsResponse) GetCloudDatabaseClusterUserCredentials() getCloudDatabaseClusterUserCredentialsCloudDatabaseClusterUserCredentialsDatabaseUser {
+func (v *getCloudDatabaseClusterFeaturesCloudDatabaseCluster) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getCloudDatabaseClusterFeaturesCloudDatabaseCluster) __premarshalJSON() (*__premarshalgetCloudDatabaseClusterFeaturesCloudDatabaseCluster, error) {
+	var retval __premarshalgetCloudDatabaseClusterFeaturesCloudDatabaseCluster
+
+	{
+
+		dst := &retval.Features
+		src := v.Features
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalgetCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeature(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal getCloudDatabaseClusterFeaturesCloudDatabaseCluster.Features: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeature includes the requested fields of the GraphQL interface CloudDatabaseClusterFeature.
+//
+// getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeature is implemented by the following types:
+// getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl
+type getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeature interface {
+	implementsGraphQLInterfacegetCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeature()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+	CloudDatabaseClusterFeatureResult
+}
+
+func (v *getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl) implementsGraphQLInterfacegetCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeature() {
+}
+
+func __unmarshalgetCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeature(b []byte, v *getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeature) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CloudDatabaseClusterFeatureImpl":
+		*v = new(getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing CloudDatabaseClusterFeature.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeature: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeature(v *getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeature) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl:
+		typename = "CloudDatabaseClusterFeatureImpl"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalgetCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeature: "%T"`, v)
+	}
+}
+
+// getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl includes the requested fields of the GraphQL type CloudDatabaseClusterFeatureImpl.
+type getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl struct {
+	Typename                                                         *string `json:"__typename"`
+	CloudDatabaseClusterFeatureResultCloudDatabaseClusterFeatureImpl `json:"-"`
+}
+
+// GetTypename returns getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl.Typename, and is useful for accessing the field via an interface.
+func (v *getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl) GetTypename() *string {
+	return v.Typename
+}
+
+// GetEnabled returns getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl.Enabled, and is useful for accessing the field via an interface.
+func (v *getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl) GetEnabled() bool {
+	return v.CloudDatabaseClusterFeatureResultCloudDatabaseClusterFeatureImpl.Enabled
+}
+
+// GetStatus returns getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl.Status, and is useful for accessing the field via an interface.
+func (v *getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl) GetStatus() string {
+	return v.CloudDatabaseClusterFeatureResultCloudDatabaseClusterFeatureImpl.Status
+}
+
+func (v *getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CloudDatabaseClusterFeatureResultCloudDatabaseClusterFeatureImpl)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl struct {
+	Typename *string `json:"__typename"`
+
+	Enabled bool `json:"enabled"`
+
+	Status string `json:"status"`
+}
+
+func (v *getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl) __premarshalJSON() (*__premarshalgetCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl, error) {
+	var retval __premarshalgetCloudDatabaseClusterFeaturesCloudDatabaseClusterFeaturesCloudDatabaseClusterFeatureImpl
+
+	retval.Typename = v.Typename
+	retval.Enabled = v.CloudDatabaseClusterFeatureResultCloudDatabaseClusterFeatureImpl.Enabled
+	retval.Status = v.CloudDatabaseClusterFeatureResultCloudDatabaseClusterFeatureImpl.Status
+	return &retval, nil
+}
+
+// getCloudDatabaseClusterFeaturesResponse is returned by getCloudDatabaseClusterFeatures on success.
+type getCloudDatabaseClusterFeaturesResponse struct {
+	// Cost: complexity = 100, multipliers = [], defaultMultiplier = null
+	CloudDatabaseCluster getCloudDatabaseClusterFeaturesCloudDatabaseCluster `json:"cloudDatabaseCluster"`
+}
+
+// GetCloudDatabaseCluster returns getCloudDatabaseClusterFeaturesResponse.CloudDatabaseCluster, and is useful for accessing the field via an interface.
+func (v *getCloudDatabaseClusterFeaturesResponse) GetCloudDatabaseCluster() getCloudDatabaseClusterFeaturesCloudDatabaseCluster {
+	return v.CloudDatabaseCluster
+}
+
+// getCloudDatabaseClusterResponse is returned by getCloudDatabaseCluster on success.
+type getCloudDatabaseClusterResponse struct {
+	// Cost: complexity = 100, multipliers = [], defaultMultiplier = null
+	CloudDatabaseCluster CloudDatabaseClusterResult `json:"cloudDatabaseCluster"`
+}
+
+// GetCloudDatabaseCluster returns getCloudDatabaseClusterResponse.CloudDatabaseCluster, and is useful for accessing the field via an interface.
+func (v *getCloudDatabaseClusterResponse) GetCloudDatabaseCluster() CloudDatabaseClusterResult {
+	return v.CloudDatabaseCluster
+}
+
+// getCloudDatabaseClusterUserCredentialsResponse is returned by getCloudDatabaseClusterUserCredentials on success.
+type getCloudDatabaseClusterUserCredentialsResponse struct {
+	// Cost: complexity = 750, multipliers = [], defaultMultiplier = null
+	CloudDatabaseClusterUserCredentials CloudDatabaseClusterUserResult `json:"cloudDatabaseClusterUserCredentials"`
+}
+
+// GetCloudDatabaseClusterUserCredentials returns getCloudDatabaseClusterUserCredentialsResponse.CloudDatabaseClusterUserCredentials, and is useful for accessing the field via an interface.
+func (v *getCloudDatabaseClusterUserCredentialsResponse) GetCloudDatabaseClusterUserCredentials() CloudDatabaseClusterUserResult {
 	return v.CloudDatabaseClusterUserCredentials
 }
 
@@ -4218,6 +5051,17 @@ func (v *messageQueueUserCredentialsGetResponse) GetMessageQueueUserCredentials(
 	return v.MessageQueueUserCredentials
 }
 
+// messageQueueUserModifyResponse is returned by messageQueueUserModify on success.
+type messageQueueUserModifyResponse struct {
+	// Cost: complexity = 100, multipliers = [], defaultMultiplier = null
+	MessageQueueUserModify MessageQueueUserCredentialsResult `json:"messageQueueUserModify"`
+}
+
+// GetMessageQueueUserModify returns messageQueueUserModifyResponse.MessageQueueUserModify, and is useful for accessing the field via an interface.
+func (v *messageQueueUserModifyResponse) GetMessageQueueUserModify() MessageQueueUserCredentialsResult {
+	return v.MessageQueueUserModify
+}
+
 // messageQueueVersionsGetResponse is returned by messageQueueVersionsGet on success.
 type messageQueueVersionsGetResponse struct {
 	// Cost: complexity = 100, multipliers = [], defaultMultiplier = null
@@ -4361,6 +5205,15 @@ type namespaceDeleteResponse struct {
 // GetNamespaceDelete returns namespaceDeleteResponse.NamespaceDelete, and is useful for accessing the field via an interface.
 func (v *namespaceDeleteResponse) GetNamespaceDelete() bool { return v.NamespaceDelete }
 
+// namespaceDescribeResponse is returned by namespaceDescribe on success.
+type namespaceDescribeResponse struct {
+	// Cost: complexity = 100, multipliers = [], defaultMultiplier = null
+	Namespace NamespaceDescription `json:"namespace"`
+}
+
+// GetNamespace returns namespaceDescribeResponse.Namespace, and is useful for accessing the field via an interface.
+func (v *namespaceDescribeResponse) GetNamespace() NamespaceDescription { return v.Namespace }
+
 // namespaceListByNameNamespace includes the requested fields of the GraphQL type Namespace.
 type namespaceListByNameNamespace struct {
 	NamespaceResult `json:"-"`
@@ -4400,6 +5253,11 @@ func (v *namespaceListByNameNamespace) GetMessageQueues() []NamespaceResultMessa
 	return v.NamespaceResult.MessageQueues
 }
 
+// GetPrivateRegistries returns namespaceListByNameNamespace.PrivateRegistries, and is useful for accessing the field via an interface.
+func (v *namespaceListByNameNamespace) GetPrivateRegistries() []NamespaceResultPrivateRegistriesPrivateRegistry {
+	return v.NamespaceResult.PrivateRegistries
+}
+
 func (v *namespaceListByNameNamespace) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -4441,6 +5299,8 @@ type __premarshalnamespaceListByNameNamespace struct {
 	CloudDatabaseClusters []NamespaceResultCloudDatabaseClustersCloudDatabaseCluster `json:"cloudDatabaseClusters"`
 
 	MessageQueues []NamespaceResultMessageQueuesMessageQueue `json:"messageQueues"`
+
+	PrivateRegistries []NamespaceResultPrivateRegistriesPrivateRegistry `json:"privateRegistries"`
 }
 
 func (v *namespaceListByNameNamespace) MarshalJSON() ([]byte, error) {
@@ -4462,6 +5322,7 @@ func (v *namespaceListByNameNamespace) __premarshalJSON() (*__premarshalnamespac
 	retval.Volumes = v.NamespaceResult.Volumes
 	retval.CloudDatabaseClusters = v.NamespaceResult.CloudDatabaseClusters
 	retval.MessageQueues = v.NamespaceResult.MessageQueues
+	retval.PrivateRegistries = v.NamespaceResult.PrivateRegistries
 	return &retval, nil
 }
 
@@ -4515,6 +5376,11 @@ func (v *namespaceListNamespacesNamespace) GetMessageQueues() []NamespaceResultM
 	return v.NamespaceResult.MessageQueues
 }
 
+// GetPrivateRegistries returns namespaceListNamespacesNamespace.PrivateRegistries, and is useful for accessing the field via an interface.
+func (v *namespaceListNamespacesNamespace) GetPrivateRegistries() []NamespaceResultPrivateRegistriesPrivateRegistry {
+	return v.NamespaceResult.PrivateRegistries
+}
+
 func (v *namespaceListNamespacesNamespace) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -4556,6 +5422,8 @@ type __premarshalnamespaceListNamespacesNamespace struct {
 	CloudDatabaseClusters []NamespaceResultCloudDatabaseClustersCloudDatabaseCluster `json:"cloudDatabaseClusters"`
 
 	MessageQueues []NamespaceResultMessageQueuesMessageQueue `json:"messageQueues"`
+
+	PrivateRegistries []NamespaceResultPrivateRegistriesPrivateRegistry `json:"privateRegistries"`
 }
 
 func (v *namespaceListNamespacesNamespace) MarshalJSON() ([]byte, error) {
@@ -4577,6 +5445,7 @@ func (v *namespaceListNamespacesNamespace) __premarshalJSON() (*__premarshalname
 	retval.Volumes = v.NamespaceResult.Volumes
 	retval.CloudDatabaseClusters = v.NamespaceResult.CloudDatabaseClusters
 	retval.MessageQueues = v.NamespaceResult.MessageQueues
+	retval.PrivateRegistries = v.NamespaceResult.PrivateRegistries
 	return &retval, nil
 }
 
@@ -4653,6 +5522,11 @@ func (v *registryListNamespacePrivateRegistriesPrivateRegistry) GetLocked() bool
 	return v.RegistryResult.Locked
 }
 
+// GetCreatedAt returns registryListNamespacePrivateRegistriesPrivateRegistry.CreatedAt, and is useful for accessing the field via an interface.
+func (v *registryListNamespacePrivateRegistriesPrivateRegistry) GetCreatedAt() string {
+	return v.RegistryResult.CreatedAt
+}
+
 func (v *registryListNamespacePrivateRegistriesPrivateRegistry) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -4688,6 +5562,8 @@ type __premarshalregistryListNamespacePrivateRegistriesPrivateRegistry struct {
 	State string `json:"state"`
 
 	Locked bool `json:"locked"`
+
+	CreatedAt string `json:"createdAt"`
 }
 
 func (v *registryListNamespacePrivateRegistriesPrivateRegistry) MarshalJSON() ([]byte, error) {
@@ -4706,6 +5582,7 @@ func (v *registryListNamespacePrivateRegistriesPrivateRegistry) __premarshalJSON
 	retval.Username = v.RegistryResult.Username
 	retval.State = v.RegistryResult.State
 	retval.Locked = v.RegistryResult.Locked
+	retval.CreatedAt = v.RegistryResult.CreatedAt
 	return &retval, nil
 }
 
@@ -4718,6 +5595,17 @@ type registryListResponse struct {
 // GetNamespace returns registryListResponse.Namespace, and is useful for accessing the field via an interface.
 func (v *registryListResponse) GetNamespace() registryListNamespace { return v.Namespace }
 
+// resourceSpecificationsResponse is returned by resourceSpecifications on success.
+type resourceSpecificationsResponse struct {
+	// Cost: complexity = 100, multipliers = [], defaultMultiplier = null
+	ResourceSpecifications []ResourceSpecificationResult `json:"resourceSpecifications"`
+}
+
+// GetResourceSpecifications returns resourceSpecificationsResponse.ResourceSpecifications, and is useful for accessing the field via an interface.
+func (v *resourceSpecificationsResponse) GetResourceSpecifications() []ResourceSpecificationResult {
+	return v.ResourceSpecifications
+}
+
 // volumeCreateResponse is returned by volumeCreate on success.
 type volumeCreateResponse struct {
 	// Cost: complexity = 100, multipliers = [], defaultMultiplier = null
@@ -4854,6 +5742,91 @@ type volumeListResponse struct {
 // GetNamespace returns volumeListResponse.Namespace, and is useful for accessing the field via an interface.
 func (v *volumeListResponse) GetNamespace() volumeListNamespace { return v.Namespace }
 
+// The query executed by account.
+const account_Operation = `
+query account {
+	account {
+		customer {
+			id
+		}
+	}
+}
+`
+
+func account(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (data_ *accountResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "account",
+		Query:  account_Operation,
+	}
+
+	data_ = &accountResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by auditLogs.
+const auditLogs_Operation = `
+query auditLogs ($customerId: ID!, $filter: AuditLogFilterInput) {
+	auditLogs(customerId: $customerId, filter: $filter) {
+		... AuditLogResult
+	}
+}
+fragment AuditLogResult on AuditLog {
+	id
+	eventType
+	modelId
+	modelName
+	occurredAt
+	tags
+	account {
+		name
+		email
+	}
+	changeSet {
+		name
+		oldValue
+		newValue
+	}
+}
+`
+
+func auditLogs(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	customerId string,
+	filter *AuditLogFilterInput,
+) (data_ *auditLogsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "auditLogs",
+		Query:  auditLogs_Operation,
+		Variables: &__auditLogsInput{
+			CustomerId: customerId,
+			Filter:     filter,
+		},
+	}
+
+	data_ = &auditLogsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The mutation executed by cloudDatabaseClusterCreate.
 const cloudDatabaseClusterCreate_Operation = `
 mutation cloudDatabaseClusterCreate ($cloudDatabaseClusterInput: CloudDatabaseClusterCreateInput!) {
@@ -4868,6 +5841,7 @@ fragment CloudDatabaseClusterResult on CloudDatabaseCluster {
 	}
 	name
 	hostname
+	port
 	namespace {
 		name
 	}
@@ -5069,6 +6043,7 @@ fragment CloudDatabaseClusterResult on CloudDatabaseCluster {
 	}
 	name
 	hostname
+	port
 	namespace {
 		name
 	}
@@ -6151,6 +7126,7 @@ fragment CloudDatabaseClusterResult on CloudDatabaseCluster {
 	}
 	name
 	hostname
+	port
 	namespace {
 		name
 	}
@@ -6276,13 +7252,65 @@ func getCloudDatabaseClusterDatabases(
 	return data_, err_
 }
 
+// The query executed by getCloudDatabaseClusterFeatures.
+const getCloudDatabaseClusterFeatures_Operation = `
+query getCloudDatabaseClusterFeatures ($cloudDatabaseClusterInput: CloudDatabaseClusterResourceInput!) {
+	cloudDatabaseCluster(cloudDatabase: $cloudDatabaseClusterInput) {
+		features {
+			__typename
+			... CloudDatabaseClusterFeatureResult
+		}
+	}
+}
+fragment CloudDatabaseClusterFeatureResult on CloudDatabaseClusterFeature {
+	enabled
+	status
+}
+`
+
+func getCloudDatabaseClusterFeatures(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	cloudDatabaseClusterInput CloudDatabaseClusterResourceInput,
+) (data_ *getCloudDatabaseClusterFeaturesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "getCloudDatabaseClusterFeatures",
+		Query:  getCloudDatabaseClusterFeatures_Operation,
+		Variables: &__getCloudDatabaseClusterFeaturesInput{
+			CloudDatabaseClusterInput: cloudDatabaseClusterInput,
+		},
+	}
+
+	data_ = &getCloudDatabaseClusterFeaturesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The query executed by getCloudDatabaseClusterUserCredentials.
 const getCloudDatabaseClusterUserCredentials_Operation = `
 query getCloudDatabaseClusterUserCredentials ($cloudDatabase: CloudDatabaseClusterResourceInput!, $userName: String!) {
 	cloudDatabaseClusterUserCredentials(cloudDatabase: $cloudDatabase, username: $userName) {
-		dsn
+		... CloudDatabaseClusterUserResult
 	}
 }
+fragment CloudDatabaseClusterUserResult on DatabaseUser {
+	name
+	status
+	permissions {
+		databaseName
+		permission
+	}
+	dsn
+	password
+	role
+}
 `
 
 func getCloudDatabaseClusterUserCredentials(
@@ -6373,6 +7401,7 @@ fragment CloudDatabaseClusterResult on CloudDatabaseCluster {
 	}
 	name
 	hostname
+	port
 	namespace {
 		name
 	}
@@ -6498,6 +7527,11 @@ fragment MessageQueuePlanResult on MessageQueuePlan {
 	}
 	replicas
 	storage(unit: GB)
+	benchmark {
+		connections
+		maximumMessageRate
+		minimumMessageRate
+	}
 }
 fragment MessageQueueVersionResult on MessageQueueSpec {
 	patchLevelVersion
@@ -6506,6 +7540,9 @@ fragment MessageQueueVersionResult on MessageQueueSpec {
 }
 fragment MessageQueueIngressResult on MessageQueueIngress {
 	allowList
+	domainName
+	status
+	tlsEnabled
 }
 fragment ExternalConnectionResult on ExternalConnection {
 	ipv4
@@ -6621,6 +7658,11 @@ fragment MessageQueuePlanResult on MessageQueuePlan {
 	}
 	replicas
 	storage(unit: GB)
+	benchmark {
+		connections
+		maximumMessageRate
+		minimumMessageRate
+	}
 }
 fragment MessageQueueVersionResult on MessageQueueSpec {
 	patchLevelVersion
@@ -6629,6 +7671,9 @@ fragment MessageQueueVersionResult on MessageQueueSpec {
 }
 fragment MessageQueueIngressResult on MessageQueueIngress {
 	allowList
+	domainName
+	status
+	tlsEnabled
 }
 fragment ExternalConnectionResult on ExternalConnection {
 	ipv4
@@ -6712,6 +7757,11 @@ fragment MessageQueuePlanResult on MessageQueuePlan {
 	}
 	replicas
 	storage(unit: GB)
+	benchmark {
+		connections
+		maximumMessageRate
+		minimumMessageRate
+	}
 }
 fragment MessageQueueVersionResult on MessageQueueSpec {
 	patchLevelVersion
@@ -6720,6 +7770,9 @@ fragment MessageQueueVersionResult on MessageQueueSpec {
 }
 fragment MessageQueueIngressResult on MessageQueueIngress {
 	allowList
+	domainName
+	status
+	tlsEnabled
 }
 fragment ExternalConnectionResult on ExternalConnection {
 	ipv4
@@ -6777,6 +7830,11 @@ fragment MessageQueuePlanResult on MessageQueuePlan {
 	}
 	replicas
 	storage(unit: GB)
+	benchmark {
+		connections
+		maximumMessageRate
+		minimumMessageRate
+	}
 }
 `
 
@@ -6844,6 +7902,47 @@ func messageQueueUserCredentialsGet(
 	return data_, err_
 }
 
+// The mutation executed by messageQueueUserModify.
+const messageQueueUserModify_Operation = `
+mutation messageQueueUserModify ($userInput: CloudDatabaseClusterUserModifyInput!) {
+	messageQueueUserModify(userInput: $userInput) {
+		... MessageQueueUserCredentialsResult
+	}
+}
+fragment MessageQueueUserCredentialsResult on MessageQueueUser {
+	name
+	dsn
+	password
+	role
+	status
+}
+`
+
+func messageQueueUserModify(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	userInput CloudDatabaseClusterUserModifyInput,
+) (data_ *messageQueueUserModifyResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "messageQueueUserModify",
+		Query:  messageQueueUserModify_Operation,
+		Variables: &__messageQueueUserModifyInput{
+			UserInput: userInput,
+		},
+	}
+
+	data_ = &messageQueueUserModifyResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The query executed by messageQueueVersionsGet.
 const messageQueueVersionsGet_Operation = `
 query messageQueueVersionsGet {
@@ -6924,6 +8023,11 @@ fragment MessageQueuePlanResult on MessageQueuePlan {
 	}
 	replicas
 	storage(unit: GB)
+	benchmark {
+		connections
+		maximumMessageRate
+		minimumMessageRate
+	}
 }
 fragment MessageQueueVersionResult on MessageQueueSpec {
 	patchLevelVersion
@@ -6932,6 +8036,9 @@ fragment MessageQueueVersionResult on MessageQueueSpec {
 }
 fragment MessageQueueIngressResult on MessageQueueIngress {
 	allowList
+	domainName
+	status
+	tlsEnabled
 }
 fragment ExternalConnectionResult on ExternalConnection {
 	ipv4
@@ -7037,6 +8144,9 @@ fragment NamespaceResult on Namespace {
 	messageQueues {
 		name
 	}
+	privateRegistries {
+		name
+	}
 }
 `
 
@@ -7097,6 +8207,86 @@ func namespaceDelete(
 	return data_, err_
 }
 
+// The query executed by namespaceDescribe.
+const namespaceDescribe_Operation = `
+query namespaceDescribe ($name: String!) {
+	namespace(name: $name) {
+		... NamespaceDescription
+	}
+}
+fragment NamespaceDescription on Namespace {
+	name
+	description
+	state
+	containers {
+		name
+		state
+		locked
+		availableReplicas
+		numberOfReplicas
+	}
+	containerJobs {
+		name
+		state
+		locked
+		enabled
+		schedule
+		runs {
+			name
+			status
+			startTime
+		}
+	}
+	volumes {
+		name
+		state
+		locked
+		size
+		usage
+	}
+	cloudDatabaseClusters {
+		name
+		state
+		locked
+	}
+	messageQueues {
+		name
+		state
+		locked
+	}
+	privateRegistries {
+		name
+		state
+		locked
+	}
+}
+`
+
+func namespaceDescribe(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	name string,
+) (data_ *namespaceDescribeResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "namespaceDescribe",
+		Query:  namespaceDescribe_Operation,
+		Variables: &__namespaceDescribeInput{
+			Name: name,
+		},
+	}
+
+	data_ = &namespaceDescribeResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The query executed by namespaceList.
 const namespaceList_Operation = `
 query namespaceList {
@@ -7123,6 +8313,9 @@ fragment NamespaceResult on Namespace {
 	messageQueues {
 		name
 	}
+	privateRegistries {
+		name
+	}
 }
 `
 
@@ -7173,6 +8366,9 @@ fragment NamespaceResult on Namespace {
 	messageQueues {
 		name
 	}
+	privateRegistries {
+		name
+	}
 }
 `
 
@@ -7214,6 +8410,7 @@ fragment RegistryResult on PrivateRegistry {
 	username
 	state
 	locked
+	createdAt
 }
 `
 
@@ -7291,6 +8488,7 @@ fragment RegistryResult on PrivateRegistry {
 	username
 	state
 	locked
+	createdAt
 }
 `
 
@@ -7319,6 +8517,50 @@ func registryList(
 	return data_, err_
 }
 
+// The query executed by resourceSpecifications.
+const resourceSpecifications_Operation = `
+query resourceSpecifications ($kind: String!) {
+	resourceSpecifications(kind: $kind) {
+		... ResourceSpecificationResult
+	}
+}
+fragment ResourceSpecificationResult on ResourceSpecification {
+	id
+	kind
+	cpu
+	ram
+	price {
+		amount
+		currency
+	}
+}
+`
+
+func resourceSpecifications(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	kind string,
+) (data_ *resourceSpecificationsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "resourceSpecifications",
+		Query:  resourceSpecifications_Operation,
+		Variables: &__resourceSpecificationsInput{
+			Kind: kind,
+		},
+	}
+
+	data_ = &resourceSpecificationsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The mutation executed by volumeCreate.
 const volumeCreate_Operation = `
 mutation volumeCreate ($input: VolumeCreateInput!) {
@@ -4,8 +4,8 @@ import (
 	"context"
 )
 
-func (client *Client) MessageQueueList() ([]MessageQueueResult, error) {
-	resp, err := messageQueuesGet(context.Background(), *client.client)
+func (client *Client) MessageQueueList(ctx context.Context) ([]MessageQueueResult, error) {
+	resp, err := messageQueuesGet(ctx, *client.client)
 	if err != nil {
 		return []MessageQueueResult{}, err
 	}
@@ -15,40 +15,40 @@ func (client *Client) MessageQueueList() ([]MessageQueueResult, error) {
 	return result, nil
 }
 
-func (client *Client) MessageQueueGet(input MessageQueueResourceInput) (MessageQueueResult, error) {
-	resp, err := messageQueueGet(context.Background(), *client.client, input)
+func (client *Client) MessageQueueGet(ctx context.Context, input MessageQueueResourceInput) (MessageQueueResult, error) {
+	resp, err := messageQueueGet(ctx, *client.client, input)
 	if err != nil {
 		return MessageQueueResult{}, err
 	}
 	return resp.GetMessageQueue(), nil
 }
 
-func (client *Client) MessageQueueCreate(input MessageQueueCreateInput) (MessageQueueResult, error) {
-	resp, err := messageQueueCreate(context.Background(), *client.client, input)
+func (client *Client) MessageQueueCreate(ctx context.Context, input MessageQueueCreateInput) (MessageQueueResult, error) {
+	resp, err := messageQueueCreate(ctx, *client.client, input)
 	if err != nil {
 		return MessageQueueResult{}, err
 	}
 	return resp.GetMessageQueueCreate(), nil
 }
 
-func (client *Client) MessageQueueModify(input MessageQueueModifyInput) (MessageQueueResult, error) {
-	resp, err := messageQueueModify(context.Background(), *client.client, input)
+func (client *Client) MessageQueueModify(ctx context.Context, input MessageQueueModifyInput) (MessageQueueResult, error) {
+	resp, err := messageQueueModify(ctx, *client.client, input)
 	if err != nil {
 		return MessageQueueResult{}, err
 	}
 	return resp.GetMessageQueueModify(), nil
 }
 
-func (client *Client) MessageQueueDelete(input MessageQueueResourceInput) (bool, error) {
-	resp, err := messageQueueDelete(context.Background(), *client.client, input)
+func (client *Client) MessageQueueDelete(ctx context.Context, input MessageQueueResourceInput) (bool, error) {
+	resp, err := messageQueueDelete(ctx, *client.client, input)
 	if err != nil {
 		return false, err
 	}
 	return resp.GetMessageQueueDelete(), nil
 }
 
-func (client *Client) MessageQueuePlans() ([]MessageQueuePlanResult, error) {
-	resp, err := messageQueuePlansGet(context.Background(), *client.client)
+func (client *Client) MessageQueuePlans(ctx context.Context) ([]MessageQueuePlanResult, error) {
+	resp, err := messageQueuePlansGet(ctx, *client.client)
 	if err != nil {
 		return []MessageQueuePlanResult{}, err
 	}
@@ -58,8 +58,8 @@ func (client *Client) MessageQueuePlans() ([]MessageQueuePlanResult, error) {
 	return result, nil
 }
 
-func (client *Client) MessageQueueVersions() ([]MessageQueueVersionResult, error) {
-	resp, err := messageQueueVersionsGet(context.Background(), *client.client)
+func (client *Client) MessageQueueVersions(ctx context.Context) ([]MessageQueueVersionResult, error) {
+	resp, err := messageQueueVersionsGet(ctx, *client.client)
 	if err != nil {
 		return []MessageQueueVersionResult{}, err
 	}
@@ -69,10 +69,18 @@ func (client *Client) MessageQueueVersions() ([]MessageQueueVersionResult, error
 	return result, nil
 }
 
-func (client *Client) MessageQueueAdminCredentials(input MessageQueueResourceInput, username string) (MessageQueueUserCredentialsResult, error) {
-	resp, err := messageQueueUserCredentialsGet(context.Background(), *client.client, input, username)
+func (client *Client) MessageQueueAdminCredentials(ctx context.Context, input MessageQueueResourceInput, username string) (MessageQueueUserCredentialsResult, error) {
+	resp, err := messageQueueUserCredentialsGet(ctx, *client.client, input, username)
 	if err != nil {
 		return MessageQueueUserCredentialsResult{}, err
 	}
 	return resp.GetMessageQueueUserCredentials(), nil
 }
+
+func (client *Client) MessageQueueUserModify(ctx context.Context, input CloudDatabaseClusterUserModifyInput) (MessageQueueUserCredentialsResult, error) {
+	resp, err := messageQueueUserModify(ctx, *client.client, input)
+	if err != nil {
+		return MessageQueueUserCredentialsResult{}, err
+	}
+	return resp.GetMessageQueueUserModify(), nil
+}
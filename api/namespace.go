@@ -2,8 +2,8 @@ package api
 
 import "context"
 
-func (client *Client) NamespacesList() ([]NamespaceResult, error) {
-	namespaceResponse, err := namespaceList(context.Background(), *client.client)
+func (client *Client) NamespacesList(ctx context.Context) ([]NamespaceResult, error) {
+	namespaceResponse, err := namespaceList(ctx, *client.client)
 	if err != nil {
 		return []NamespaceResult{}, err
 	}
@@ -21,24 +21,19 @@ func (client *Client) NamespacesList() ([]NamespaceResult, error) {
 	return result, nil
 }
 
-func (client *Client) NamespaceListByName(name string) (NamespaceResult, error) {
-	namespaceResponse, err := namespaceListByName(context.Background(), *client.client, name)
+func (client *Client) NamespaceListByName(ctx context.Context, name string) (NamespaceResult, error) {
+	namespaceResponse, err := namespaceListByName(ctx, *client.client, name)
 	if err != nil {
 		return NamespaceResult{}, err
 	}
 
 	namespaceResult := namespaceResponse.GetNamespace()
 
-	result := NamespaceResult{
-		Name:        namespaceResult.Name,
-		Description: namespaceResult.Description,
-	}
-
-	return result, nil
+	return namespaceResult.NamespaceResult, nil
 }
 
-func (client *Client) NamespaceCreate(input NamespaceCreateInput) (NamespaceResult, error) {
-	namespaceCreateResponse, err := namespaceCreate(context.Background(), *client.client, input)
+func (client *Client) NamespaceCreate(ctx context.Context, input NamespaceCreateInput) (NamespaceResult, error) {
+	namespaceCreateResponse, err := namespaceCreate(ctx, *client.client, input)
 	if err != nil {
 		return NamespaceResult{}, err
 	}
@@ -46,11 +41,20 @@ func (client *Client) NamespaceCreate(input NamespaceCreateInput) (NamespaceResu
 	return namespaceCreateResponse.GetNamespaceCreate(), nil
 }
 
-func (client *Client) NamespaceDelete(name string) (bool, error) {
-	namespaceDeleteResponse, err := namespaceDelete(context.Background(), *client.client, name)
+func (client *Client) NamespaceDelete(ctx context.Context, name string) (bool, error) {
+	namespaceDeleteResponse, err := namespaceDelete(ctx, *client.client, name)
 	if err != nil {
 		return false, err
 	}
 
 	return namespaceDeleteResponse.GetNamespaceDelete(), nil
 }
+
+func (client *Client) NamespaceDescribe(ctx context.Context, name string) (NamespaceDescription, error) {
+	namespaceDescribeResponse, err := namespaceDescribe(ctx, *client.client, name)
+	if err != nil {
+		return NamespaceDescription{}, err
+	}
+
+	return namespaceDescribeResponse.GetNamespace(), nil
+}
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// RawResponse is the response to a query that is not generated, with the data left as JSON.
+type RawResponse struct {
+	Data   json.RawMessage `json:"data,omitempty"`
+	Errors gqlerror.List   `json:"errors,omitempty"`
+}
+
+// Raw sends a query with the given variables. Errors returned by the API are part of the response,
+// the returned error is only set when the query is invalid or the request failed.
+func (client *Client) Raw(ctx context.Context, query string, variables map[string]any) (RawResponse, error) {
+	var data json.RawMessage
+	response := graphql.Response{Data: &data}
+
+	err := (*client.client).MakeRequest(ctx, &graphql.Request{Query: query, Variables: variables}, &response)
+	if err != nil && len(response.Errors) == 0 {
+		return RawResponse{}, err
+	}
+
+	return RawResponse{Data: data, Errors: response.Errors}, nil
+}
+
+// QueryVariables parses the query against the schema and converts the given values to the types of the
+// variables it declares, so -F count=3 sends a number for an Int and a string for a String. Lists and
+// input objects are read as JSON, and null sends null for any type.
+func QueryVariables(query string, values map[string]string) (map[string]any, error) {
+	schema, err := Schema()
+	if err != nil {
+		return nil, err
+	}
+
+	document, errs := gqlparser.LoadQuery(schema, query)
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	definitions := map[string]*ast.Type{}
+	for _, operation := range document.Operations {
+		for _, definition := range operation.VariableDefinitions {
+			definitions[definition.Variable] = definition.Type
+		}
+	}
+
+	variables := map[string]any{}
+	for name, value := range values {
+		typ, ok := definitions[name]
+		if !ok {
+			return nil, fmt.Errorf("the query does not declare $%s%s", name, declaredVariables(definitions))
+		}
+
+		converted, err := variableValue(schema, typ, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for $%s: %v", name, err)
+		}
+		variables[name] = converted
+	}
+
+	return variables, nil
+}
+
+func declaredVariables(definitions map[string]*ast.Type) string {
+	if len(definitions) == 0 {
+		return ", it has no variables"
+	}
+
+	names := make([]string, 0, len(definitions))
+	for name, typ := range definitions {
+		names = append(names, fmt.Sprintf("$%s: %s", name, typ))
+	}
+	sort.Strings(names)
+	return ", it declares " + strings.Join(names, ", ")
+}
+
+func variableValue(schema *ast.Schema, typ *ast.Type, value string) (any, error) {
+	if value == "null" {
+		return nil, nil
+	}
+
+	definition := schema.Types[typ.Name()]
+	if typ.Elem != nil || (definition != nil && definition.Kind == ast.InputObject) {
+		var decoded any
+		if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+			return nil, fmt.Errorf("expected JSON for %s: %v", typ, err)
+		}
+		return decoded, nil
+	}
+
+	switch typ.Name() {
+	case "Int":
+		return strconv.Atoi(value)
+	case "Float":
+		return strconv.ParseFloat(value, 64)
+	case "Boolean":
+		return strconv.ParseBool(value)
+	}
+	return value, nil
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryVariables(t *testing.T) {
+	query := `query($n: Int, $id: String!, $filter: AuditLogFilterInput, $customer: ID!) {
+		nodeTypes(numberOfNodes: $n) { id }
+		nodeType(id: $id) { id }
+		auditLogs(customerId: $customer, filter: $filter) { id }
+	}`
+
+	variables, err := QueryVariables(query, map[string]string{
+		"n":        "3",
+		"id":       "123",
+		"filter":   `{"eventTypes": ["create"]}`,
+		"customer": "42",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"n":        3,
+		"id":       "123",
+		"filter":   map[string]any{"eventTypes": []any{"create"}},
+		"customer": "42",
+	}, variables)
+
+	variables, err = QueryVariables(query, map[string]string{"n": "null"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"n": nil}, variables)
+}
+
+func TestQueryVariablesErrors(t *testing.T) {
+	_, err := QueryVariables(`query { namespaces { nme } }`, nil)
+	assert.ErrorContains(t, err, `Did you mean "name"?`)
+
+	_, err = QueryVariables(`query($n: Int) { nodeTypes(numberOfNodes: $n) { id } }`, map[string]string{"count": "1"})
+	assert.EqualError(t, err, "the query does not declare $count, it declares $n: Int")
+
+	_, err = QueryVariables(`query($n: Int) { nodeTypes(numberOfNodes: $n) { id } }`, map[string]string{"n": "many"})
+	assert.ErrorContains(t, err, "invalid value for $n")
+
+	_, err = QueryVariables(`query($filter: AuditLogFilterInput) { auditLogs(customerId: 1, filter: $filter) { id } }`, map[string]string{"filter": "{"})
+	assert.ErrorContains(t, err, "expected JSON for AuditLogFilterInput")
+}
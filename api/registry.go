@@ -5,8 +5,8 @@ import (
 	"fmt"
 )
 
-func (client *Client) ListRegistries(namespace string) ([]RegistryResult, error) {
-	registryResponse, err := registryList(context.Background(), *client.client, namespace)
+func (client *Client) ListRegistries(ctx context.Context, namespace string) ([]RegistryResult, error) {
+	registryResponse, err := registryList(ctx, *client.client, namespace)
 	if err != nil {
 		return []RegistryResult{}, err
 	}
@@ -16,19 +16,20 @@ func (client *Client) ListRegistries(namespace string) ([]RegistryResult, error)
 	result := make([]RegistryResult, len(namespaceResult.PrivateRegistries))
 	for i, registry := range namespaceResult.PrivateRegistries {
 		result[i] = RegistryResult{
-			Name:     registry.Name,
-			Source:   registry.Source,
-			Username: registry.Username,
-			State:    registry.State,
-			Locked:   registry.Locked,
+			Name:      registry.Name,
+			Source:    registry.Source,
+			Username:  registry.Username,
+			State:     registry.State,
+			Locked:    registry.Locked,
+			CreatedAt: registry.CreatedAt,
 		}
 	}
 
 	return result, nil
 }
 
-func (client *Client) ListRegistryByName(namespace string, registryName string) (*RegistryResult, error) {
-	registries, err := client.ListRegistries(namespace)
+func (client *Client) ListRegistryByName(ctx context.Context, namespace string, registryName string) (*RegistryResult, error) {
+	registries, err := client.ListRegistries(ctx, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -42,8 +43,8 @@ func (client *Client) ListRegistryByName(namespace string, registryName string)
 	return nil, fmt.Errorf("registry %q not found in namespace %q", registryName, namespace)
 }
 
-func (client *Client) RegistryCreate(input RegistryCreateInput) (RegistryResult, error) {
-	registryCreateResponse, err := registryCreate(context.Background(), *client.client, input)
+func (client *Client) RegistryCreate(ctx context.Context, input RegistryCreateInput) (RegistryResult, error) {
+	registryCreateResponse, err := registryCreate(ctx, *client.client, input)
 	if err != nil {
 		return RegistryResult{}, err
 	}
@@ -51,8 +52,8 @@ func (client *Client) RegistryCreate(input RegistryCreateInput) (RegistryResult,
 	return registryCreateResponse.GetRegistryConnectionCreate(), nil
 }
 
-func (client *Client) RegistryDelete(namespace string, registryName string) (bool, error) {
-	registryDeleteResponse, err := registryDelete(context.Background(), *client.client, namespace, registryName)
+func (client *Client) RegistryDelete(ctx context.Context, namespace string, registryName string) (bool, error) {
+	registryDeleteResponse, err := registryDelete(ctx, *client.client, namespace, registryName)
 	if err != nil {
 		return false, err
 	}
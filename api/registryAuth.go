@@ -0,0 +1,182 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// registryBaseUrl turns a registry source such as "registry.gitlab.com/group/project"
+// into the base URL of the registry API.
+func registryBaseUrl(source string) (*url.URL, error) {
+	if !strings.Contains(source, "://") {
+		source = "https://" + source
+	}
+
+	parsed, err := url.Parse(source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid registry source %q: %w", source, err)
+	}
+	if parsed.Host == "" {
+		return nil, fmt.Errorf("invalid registry source %q: missing host", source)
+	}
+
+	switch parsed.Host {
+	case "docker.io", "index.docker.io":
+		parsed.Host = "registry-1.docker.io"
+	}
+
+	return &url.URL{Scheme: parsed.Scheme, Host: parsed.Host}, nil
+}
+
+// parseAuthenticateHeader splits a WWW-Authenticate header like
+// `Bearer realm="https://auth.docker.io/token",service="registry.docker.io"` into its scheme and parameters.
+func parseAuthenticateHeader(header string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(header), " ")
+	params := map[string]string{}
+
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, ", "), "=")
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		if key != "" {
+			params[strings.ToLower(strings.TrimSpace(key))] = value
+		}
+	}
+
+	return strings.ToLower(scheme), params
+}
+
+func registryGet(httpClient *http.Client, target string, authorize func(*http.Request)) (*http.Response, error) {
+	request, err := http.NewRequest(http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	authorize(request)
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	response.Body.Close()
+	return response, nil
+}
+
+func registryToken(httpClient *http.Client, params map[string]string, username string, password string) (string, error) {
+	realm, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return "", fmt.Errorf("registry returned an invalid token realm %q", params["realm"])
+	}
+
+	query := realm.Query()
+	for _, key := range []string{"service", "scope"} {
+		if params[key] != "" {
+			query.Set(key, params[key])
+		}
+	}
+	realm.RawQuery = query.Encode()
+
+	request, err := http.NewRequest(http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	request.SetBasicAuth(username, password)
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden {
+		return "", fmt.Errorf("the registry rejected the credentials of %q", username)
+	}
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token request to %s failed with status %s", realm.Host, response.Status)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to decode token response: %w", err)
+	}
+
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	if token.AccessToken != "" {
+		return token.AccessToken, nil
+	}
+	return "", fmt.Errorf("token response from %s did not contain a token", realm.Host)
+}
+
+// VerifyRegistryCredentials performs the Docker Registry v2 authentication handshake against the source
+// and returns an error when the registry cannot be reached or rejects the credentials.
+func VerifyRegistryCredentials(httpClient *http.Client, source string, username string, password string) error {
+	base, err := registryBaseUrl(source)
+	if err != nil {
+		return err
+	}
+	target := base.JoinPath("v2/").String()
+
+	response, err := registryGet(httpClient, target, func(*http.Request) {})
+	if err != nil {
+		return fmt.Errorf("failed to reach registry %s: %w", base.Host, err)
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		// The registry allows anonymous access, so the credentials are only checked when it also inspects
+		// the credentials sent along.
+		response, err = registryGet(httpClient, target, func(request *http.Request) {
+			request.SetBasicAuth(username, password)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to reach registry %s: %w", base.Host, err)
+		}
+		if response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden {
+			return fmt.Errorf("the registry rejected the credentials of %q: %s", username, response.Status)
+		}
+		return nil
+	case http.StatusUnauthorized:
+	default:
+		return fmt.Errorf("%s does not look like a Docker registry, /v2/ returned %s", base.Host, response.Status)
+	}
+
+	var authorize func(*http.Request)
+	scheme, params := parseAuthenticateHeader(response.Header.Get("WWW-Authenticate"))
+	switch scheme {
+	case "basic":
+		authorize = func(request *http.Request) {
+			request.SetBasicAuth(username, password)
+		}
+	case "bearer":
+		token, err := registryToken(httpClient, params, username, password)
+		if err != nil {
+			return err
+		}
+		authorize = func(request *http.Request) {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+	default:
+		return fmt.Errorf("registry %s uses unsupported authentication scheme %q", base.Host, scheme)
+	}
+
+	response, err = registryGet(httpClient, target, authorize)
+	if err != nil {
+		return fmt.Errorf("failed to reach registry %s: %w", base.Host, err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("the registry rejected the credentials of %q: %s", username, response.Status)
+	}
+	return nil
+}
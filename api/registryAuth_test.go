@@ -0,0 +1,114 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistryBaseUrl(t *testing.T) {
+	base, err := registryBaseUrl("registry.gitlab.com/group/project")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://registry.gitlab.com", base.String())
+
+	base, err = registryBaseUrl("docker.io")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://registry-1.docker.io", base.String())
+
+	base, err = registryBaseUrl("http://localhost:5000")
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:5000", base.String())
+}
+
+func TestParseAuthenticateHeader(t *testing.T) {
+	scheme, params := parseAuthenticateHeader(`Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:library/nginx:pull"`)
+
+	assert.Equal(t, "bearer", scheme)
+	assert.Equal(t, map[string]string{
+		"realm":   "https://auth.docker.io/token",
+		"service": "registry.docker.io",
+		"scope":   "repository:library/nginx:pull",
+	}, params)
+
+	scheme, params = parseAuthenticateHeader(`Basic realm="Registry"`)
+	assert.Equal(t, "basic", scheme)
+	assert.Equal(t, "Registry", params["realm"])
+}
+
+func newBasicRegistry() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "user" || password != "secret" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="Registry"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func newBearerRegistry() *httptest.Server {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "user" || password != "secret" || r.URL.Query().Get("service") != "test-registry" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"token": "valid-token"})
+	})
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer valid-token" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="test-registry"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	return server
+}
+
+func TestVerifyRegistryCredentialsBasic(t *testing.T) {
+	server := newBasicRegistry()
+	defer server.Close()
+
+	assert.NoError(t, VerifyRegistryCredentials(server.Client(), server.URL, "user", "secret"))
+	assert.Error(t, VerifyRegistryCredentials(server.Client(), server.URL, "user", "wrong"))
+}
+
+func TestVerifyRegistryCredentialsBearer(t *testing.T) {
+	server := newBearerRegistry()
+	defer server.Close()
+
+	assert.NoError(t, VerifyRegistryCredentials(server.Client(), server.URL, "user", "secret"))
+	assert.Error(t, VerifyRegistryCredentials(server.Client(), server.URL, "user", "wrong"))
+}
+
+func TestVerifyRegistryCredentialsNotARegistry(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	assert.Error(t, VerifyRegistryCredentials(server.Client(), server.URL, "user", "secret"))
+}
+
+func TestVerifyRegistryCredentialsAnonymous(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if ok && (username != "user" || password != "secret") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	assert.NoError(t, VerifyRegistryCredentials(server.Client(), server.URL, "user", "secret"))
+	assert.Error(t, VerifyRegistryCredentials(server.Client(), server.URL, "user", "wrong"))
+}
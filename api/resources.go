@@ -0,0 +1,56 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ContainerSpecificationKind is the kind of the resource specifications that price containers and container jobs.
+const ContainerSpecificationKind = "container"
+
+// ParseContainerResources returns the number of CPU cores and the GB of memory of a resources value
+// such as CPU_250_RAM_500, which stands for 0.25 cores and 0.5 GB.
+func ParseContainerResources(resources ContainerResources) (float64, float64, error) {
+	var millicores, megabytes int
+	var err error
+
+	parts := strings.Split(string(resources), "_")
+	if len(parts) != 4 || parts[0] != "CPU" || parts[2] != "RAM" {
+		return 0, 0, fmt.Errorf("invalid resources %q", resources)
+	}
+	if millicores, err = strconv.Atoi(parts[1]); err != nil {
+		return 0, 0, fmt.Errorf("invalid resources %q: %w", resources, err)
+	}
+	if megabytes, err = strconv.Atoi(parts[3]); err != nil {
+		return 0, 0, fmt.Errorf("invalid resources %q: %w", resources, err)
+	}
+
+	return float64(millicores) / 1000, float64(megabytes) / 1000, nil
+}
+
+// MatchResourceSpecification returns the specification with the CPU and memory of the resources value.
+func MatchResourceSpecification(resources ContainerResources, specifications []ResourceSpecificationResult) (ResourceSpecificationResult, bool) {
+	cpu, ram, err := ParseContainerResources(resources)
+	if err != nil {
+		return ResourceSpecificationResult{}, false
+	}
+
+	for _, specification := range specifications {
+		if math.Abs(specification.Cpu-cpu) < 0.001 && math.Abs(specification.Ram-ram) < 0.001 {
+			return specification, true
+		}
+	}
+	return ResourceSpecificationResult{}, false
+}
+
+func (client *Client) ResourceSpecifications(ctx context.Context, kind string) ([]ResourceSpecificationResult, error) {
+	resp, err := resourceSpecifications(ctx, *client.client, kind)
+	if err != nil {
+		return []ResourceSpecificationResult{}, err
+	}
+
+	return resp.GetResourceSpecifications(), nil
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseContainerResources(t *testing.T) {
+	cpu, ram, err := ParseContainerResources(ContainerResourcesCpu250Ram500)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.25, cpu)
+	assert.Equal(t, 0.5, ram)
+
+	cpu, ram, err = ParseContainerResources(ContainerResourcesCpu4000Ram16000)
+	assert.NoError(t, err)
+	assert.Equal(t, 4.0, cpu)
+	assert.Equal(t, 16.0, ram)
+
+	for _, invalid := range []ContainerResources{"", "CPU_250", "CPU_x_RAM_500", "RAM_500_CPU_250"} {
+		_, _, err := ParseContainerResources(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestParseAllContainerResources(t *testing.T) {
+	for _, resources := range AllContainerResources {
+		_, _, err := ParseContainerResources(resources)
+		assert.NoError(t, err, resources)
+	}
+}
+
+func TestMatchResourceSpecification(t *testing.T) {
+	specifications := []ResourceSpecificationResult{
+		{Id: "1", Cpu: 0.25, Ram: 0.5},
+		{Id: "2", Cpu: 0.5, Ram: 1},
+	}
+
+	specification, ok := MatchResourceSpecification(ContainerResourcesCpu500Ram1000, specifications)
+	assert.True(t, ok)
+	assert.Equal(t, "2", specification.Id)
+
+	_, ok = MatchResourceSpecification(ContainerResourcesCpu4000Ram16000, specifications)
+	assert.False(t, ok)
+}
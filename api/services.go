@@ -0,0 +1,116 @@
+package api
+
+import "context"
+
+// Namespaces manages namespaces, the projects other resources are created in.
+type Namespaces interface {
+	NamespacesList(ctx context.Context) ([]NamespaceResult, error)
+	NamespaceListByName(ctx context.Context, name string) (NamespaceResult, error)
+	NamespaceCreate(ctx context.Context, input NamespaceCreateInput) (NamespaceResult, error)
+	NamespaceDelete(ctx context.Context, name string) (bool, error)
+	NamespaceDescribe(ctx context.Context, name string) (NamespaceDescription, error)
+}
+
+// Containers manages the containers in a namespace.
+type Containers interface {
+	ListContainers(ctx context.Context, namespace string) ([]ContainerResult, error)
+	ListContainerByName(ctx context.Context, namespace string, containerName string) (ContainerResult, error)
+	ContainerCreate(ctx context.Context, input ContainerCreateInput) (ContainerResult, error)
+	ContainerModify(ctx context.Context, input ContainerModifyInput) (ContainerResult, error)
+	ContainerDelete(ctx context.Context, namespace string, containerName string) (bool, error)
+}
+
+// Jobs manages the container jobs in a namespace.
+type Jobs interface {
+	ContainerJobList(ctx context.Context, namespace string) ([]ContainerJobResult, error)
+	ContainerJobByName(ctx context.Context, namespace string, name string) (ContainerJobResult, error)
+	ContainerJobCreate(ctx context.Context, input ContainerJobCreateInput) (ContainerJobResult, error)
+	ContainerJobModify(ctx context.Context, input ContainerJobModifyInput) (ContainerJobResult, error)
+	ContainerJobDelete(ctx context.Context, namespace string, containerJobName string) (bool, error)
+}
+
+// Volumes manages the volumes in a namespace.
+type Volumes interface {
+	ListVolumes(ctx context.Context, namespace string) ([]VolumeResult, error)
+	ListVolumeByName(ctx context.Context, namespace string, volumeName string) (*VolumeResult, error)
+	VolumeCreate(ctx context.Context, input VolumeCreateInput) (VolumeResult, error)
+	VolumeIncrease(ctx context.Context, input VolumeModifyInput) (VolumeResult, error)
+	VolumeDelete(ctx context.Context, namespace string, volumeName string) (bool, error)
+}
+
+// Registries manages the private registries containers pull their images from.
+type Registries interface {
+	ListRegistries(ctx context.Context, namespace string) ([]RegistryResult, error)
+	ListRegistryByName(ctx context.Context, namespace string, registryName string) (*RegistryResult, error)
+	RegistryCreate(ctx context.Context, input RegistryCreateInput) (RegistryResult, error)
+	RegistryDelete(ctx context.Context, namespace string, registryName string) (bool, error)
+}
+
+// CloudDatabaseClusters manages cloud database clusters and their databases and users.
+type CloudDatabaseClusters interface {
+	CloudDatabaseClusterList(ctx context.Context) ([]CloudDatabaseClusterResult, error)
+	CloudDatabaseClusterGet(ctx context.Context, input CloudDatabaseClusterResourceInput) (CloudDatabaseClusterResult, error)
+	CloudDatabaseClusterCreate(ctx context.Context, input CloudDatabaseClusterCreateInput) (CloudDatabaseClusterResult, error)
+	CloudDatabaseClusterModify(ctx context.Context, input CloudDatabaseClusterModifyInput) (CloudDatabaseClusterResult, error)
+	CloudDatabaseClusterDelete(ctx context.Context, input CloudDatabaseClusterResourceInput) (bool, error)
+	CloudDatabaseClusterListPlans(ctx context.Context) ([]CloudDatabaseClusterPlan, error)
+	CloudDatabaseClusterListSpecs(ctx context.Context) ([]CloudDatabaseClusterSpec, error)
+	CloudDatabaseClusterFeatures(ctx context.Context, input CloudDatabaseClusterResourceInput) ([]CloudDatabaseClusterFeature, error)
+
+	CloudDatabaseClusterDatabaseList(ctx context.Context, input CloudDatabaseClusterResourceInput) ([]CloudDatabaseClusterDatabaseResult, error)
+	CloudDatabaseClusterDatabaseCreate(ctx context.Context, input CloudDatabaseClusterDatabaseCreateInput) (CloudDatabaseClusterDatabaseResult, error)
+	CloudDatabaseClusterDatabaseDelete(ctx context.Context, input CloudDatabaseClusterDatabaseResourceInput) (bool, error)
+
+	CloudDatabaseClusterUserList(ctx context.Context, input CloudDatabaseClusterResourceInput) ([]CloudDatabaseClusterUserResult, error)
+	CloudDatabaseClusterUserGet(ctx context.Context, input CloudDatabaseClusterResourceInput, name string) (CloudDatabaseClusterUserResult, error)
+	CloudDatabaseClusterUserCreate(ctx context.Context, input CloudDatabaseClusterUserCreateInput) (CloudDatabaseClusterUserResult, error)
+	CloudDatabaseClusterUserModify(ctx context.Context, input CloudDatabaseClusterUserModifyInput) (CloudDatabaseClusterUserResult, error)
+	CloudDatabaseClusterUserDelete(ctx context.Context, input CloudDatabaseClusterUserResourceInput) (bool, error)
+	CloudDatabaseClusterUserCredentials(ctx context.Context, cloudDatabase CloudDatabaseClusterResourceInput, userName string) (string, error)
+	CloudDatabaseClusterUserCredentialsGet(ctx context.Context, cloudDatabase CloudDatabaseClusterResourceInput, userName string) (CloudDatabaseClusterUserResult, error)
+}
+
+// MessageQueues manages message queues and their users.
+type MessageQueues interface {
+	MessageQueueList(ctx context.Context) ([]MessageQueueResult, error)
+	MessageQueueGet(ctx context.Context, input MessageQueueResourceInput) (MessageQueueResult, error)
+	MessageQueueCreate(ctx context.Context, input MessageQueueCreateInput) (MessageQueueResult, error)
+	MessageQueueModify(ctx context.Context, input MessageQueueModifyInput) (MessageQueueResult, error)
+	MessageQueueDelete(ctx context.Context, input MessageQueueResourceInput) (bool, error)
+	MessageQueuePlans(ctx context.Context) ([]MessageQueuePlanResult, error)
+	MessageQueueVersions(ctx context.Context) ([]MessageQueueVersionResult, error)
+	MessageQueueAdminCredentials(ctx context.Context, input MessageQueueResourceInput, username string) (MessageQueueUserCredentialsResult, error)
+	MessageQueueUserModify(ctx context.Context, input CloudDatabaseClusterUserModifyInput) (MessageQueueUserCredentialsResult, error)
+}
+
+// Resources lists the CPU and memory specifications resources can be created with, and their prices.
+type Resources interface {
+	ResourceSpecifications(ctx context.Context, kind string) ([]ResourceSpecificationResult, error)
+}
+
+// Accounts describes the logged in account.
+type Accounts interface {
+	GetAccountId(ctx context.Context) (int, error)
+}
+
+// AuditLogs lists the changes made to the resources of a customer.
+type AuditLogs interface {
+	AuditLogs(ctx context.Context, customerId int, filter *AuditLogFilterInput) ([]AuditLogResult, error)
+}
+
+// Services is every service of the API. Client implements it against the API, the fake package in
+// memory for tests.
+type Services interface {
+	Namespaces
+	Containers
+	Jobs
+	Volumes
+	Registries
+	CloudDatabaseClusters
+	MessageQueues
+	Resources
+	Accounts
+	AuditLogs
+}
+
+var _ Services = (*Client)(nil)
@@ -0,0 +1,448 @@
+package api
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+//go:embed schema.graphql
+var schemaSource string
+
+var loadSchema = sync.OnceValues(func() (*ast.Schema, error) {
+	return gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: schemaSource})
+})
+
+// Schema returns the parsed schema the client is generated from.
+func Schema() (*ast.Schema, error) {
+	return loadSchema()
+}
+
+// ValidationError is a single problem with a value sent to the API.
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+func (err ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", err.Path, err.Message)
+}
+
+// ValidationErrors holds all problems found in the variables of a request.
+type ValidationErrors []ValidationError
+
+func (errs ValidationErrors) Error() string {
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Error()
+	}
+	return "invalid input: " + strings.Join(messages, "; ")
+}
+
+// inputRules are checks on top of the schema, keyed by input type and field. They are applied to every
+// value of the field, so list fields are checked element by element.
+var inputRules = map[string]func(value any) error{
+	"ContainerCreateInput.name":                checkName,
+	"ContainerJobCreateInput.name":             checkName,
+	"VolumeCreateInput.name":                   checkName,
+	"NamespaceCreateInput.name":                checkName,
+	"MessageQueueCreateInput.name":             checkName,
+	"CloudDatabaseClusterCreateInput.name":     checkName,
+	"AllowListInput.ip":                        checkCidr,
+	"IngressInput.whitelist":                   checkCidr,
+	"IngressInput.port":                        checkPort,
+	"IngressInput.domainName":                  checkDomainName,
+	"HealthCheckInput.port":                    checkPort,
+	"ExternalConnectionPortInput.externalPort": checkPort,
+	"ExternalConnectionPortInput.internalPort": checkPort,
+	"ContainerJobCreateInput.schedule":         checkSchedule,
+	"ContainerJobModifyInput.schedule":         checkSchedule,
+}
+
+var labelExpression = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// ValidateName checks that name can be used as a DNS label, which is required for resource names.
+func ValidateName(name string) error {
+	if len(name) > 63 {
+		return fmt.Errorf("%q is longer than 63 characters", name)
+	}
+	if !labelExpression.MatchString(name) {
+		return fmt.Errorf("%q is not a valid name, use lowercase letters, digits and '-', starting and ending with a letter or digit", name)
+	}
+	return nil
+}
+
+// ValidateDomainName checks that name is a fully qualified domain name.
+func ValidateDomainName(name string) error {
+	labels := strings.Split(strings.TrimSuffix(strings.ToLower(name), "."), ".")
+	if len(name) > 253 || len(labels) < 2 {
+		return fmt.Errorf("%q is not a valid domain name", name)
+	}
+	for _, label := range labels {
+		if len(label) > 63 || !labelExpression.MatchString(label) {
+			return fmt.Errorf("%q is not a valid domain name", name)
+		}
+	}
+	return nil
+}
+
+// ValidateCidr checks that value is an IP address or a CIDR range, such as 10.0.0.0/24.
+func ValidateCidr(value string) error {
+	if _, err := netip.ParsePrefix(value); err == nil {
+		return nil
+	}
+	if _, err := netip.ParseAddr(value); err == nil {
+		return nil
+	}
+	return fmt.Errorf("%q is not a valid IP address or CIDR range (e.g. 192.168.1.1 or 10.0.0.0/24)", value)
+}
+
+// ValidatePort checks that port is between 1 and 65535.
+func ValidatePort(port int) error {
+	if port < 1 || port > 65535 {
+		return fmt.Errorf("port %d is out of range, use 1-65535", port)
+	}
+	return nil
+}
+
+// ParseDatabasePermission returns the database permission named by value, ignoring case.
+func ParseDatabasePermission(value string) (DatabasePermission, error) {
+	options := make([]string, len(AllDatabasePermission))
+	for i, permission := range AllDatabasePermission {
+		if strings.EqualFold(value, string(permission)) {
+			return permission, nil
+		}
+		options[i] = string(permission)
+	}
+	return "", fmt.Errorf("unknown permission %q%s (valid values: %s)", value, suggestion(value, options), strings.Join(options, ", "))
+}
+
+var cronMacros = []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly"}
+
+var cronFields = []struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+// ValidateSchedule checks that schedule is a five field cron expression or a macro such as @daily.
+func ValidateSchedule(schedule string) error {
+	if strings.HasPrefix(schedule, "@") {
+		for _, macro := range cronMacros {
+			if schedule == macro {
+				return nil
+			}
+		}
+		return fmt.Errorf("unknown schedule %q%s", schedule, suggestion(schedule, cronMacros))
+	}
+
+	fields := strings.Fields(schedule)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("schedule %q has %d fields, a cron expression has 5: minute hour day-of-month month day-of-week", schedule, len(fields))
+	}
+
+	for i, field := range fields {
+		spec := cronFields[i]
+		for _, part := range strings.Split(field, ",") {
+			if err := checkCronPart(part, spec.min, spec.max, spec.names); err != nil {
+				return fmt.Errorf("invalid %s %q in schedule %q: %v", spec.name, field, schedule, err)
+			}
+		}
+	}
+	return nil
+}
+
+func checkCronPart(part string, min int, max int, names []string) error {
+	valueRange, step, hasStep := strings.Cut(part, "/")
+	if hasStep {
+		if n, err := strconv.Atoi(step); err != nil || n < 1 {
+			return fmt.Errorf("step %q must be a positive number", step)
+		}
+	}
+	if valueRange == "*" {
+		return nil
+	}
+
+	low, high, isRange := strings.Cut(valueRange, "-")
+	from, err := cronValue(low, min, max, names)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return nil
+	}
+
+	to, err := cronValue(high, min, max, names)
+	if err != nil {
+		return err
+	}
+	if from > to {
+		return fmt.Errorf("range %q is reversed", valueRange)
+	}
+	return nil
+}
+
+func cronValue(value string, min int, max int, names []string) (int, error) {
+	for i, name := range names {
+		if strings.EqualFold(value, name) {
+			return i + min, nil
+		}
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < min || n > max {
+		return 0, fmt.Errorf("%q must be between %d and %d", value, min, max)
+	}
+	return n, nil
+}
+
+func checkName(value any) error {
+	name, _ := value.(string)
+	return ValidateName(name)
+}
+
+func checkDomainName(value any) error {
+	name, _ := value.(string)
+	return ValidateDomainName(name)
+}
+
+func checkCidr(value any) error {
+	cidr, _ := value.(string)
+	return ValidateCidr(cidr)
+}
+
+func checkPort(value any) error {
+	number, _ := value.(json.Number)
+	port, err := number.Int64()
+	if err != nil {
+		return err
+	}
+	return ValidatePort(int(port))
+}
+
+func checkSchedule(value any) error {
+	schedule, _ := value.(string)
+	return ValidateSchedule(schedule)
+}
+
+// ValidateRequest checks the variables of a request against the variable definitions of the query and
+// the input types, enums and rules of the schema. It returns ValidationErrors when the variables are
+// invalid, so the request can be rejected before it is sent.
+func ValidateRequest(query string, variables any) error {
+	schema, err := Schema()
+	if err != nil {
+		return err
+	}
+
+	document, errs := gqlparser.LoadQuery(schema, query)
+	if len(errs) > 0 {
+		return errs
+	}
+
+	values := map[string]any{}
+	if variables != nil {
+		encoded, err := json.Marshal(variables)
+		if err != nil {
+			return err
+		}
+		decoder := json.NewDecoder(bytes.NewReader(encoded))
+		decoder.UseNumber()
+		if err := decoder.Decode(&values); err != nil {
+			return err
+		}
+	}
+
+	validator := validator{schema: schema}
+	for _, operation := range document.Operations {
+		for _, definition := range operation.VariableDefinitions {
+			value, ok := values[definition.Variable]
+			validator.value(definition.Variable, definition.Type, value, ok, definition.DefaultValue != nil, "")
+		}
+	}
+
+	if len(validator.errors) > 0 {
+		return validator.errors
+	}
+	return nil
+}
+
+type validator struct {
+	schema *ast.Schema
+	errors ValidationErrors
+}
+
+func (v *validator) fail(path string, format string, args ...any) {
+	v.errors = append(v.errors, ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// value checks a single value against its type. rule is the inputRules key of the field holding the value.
+// Null is accepted for non-null types with a default, the server fills in the default.
+func (v *validator) value(path string, typ *ast.Type, value any, present bool, hasDefault bool, rule string) {
+	if !present || value == nil {
+		if typ.NonNull && !hasDefault {
+			v.fail(path, "is required")
+		}
+		return
+	}
+
+	if typ.Elem != nil {
+		list, ok := value.([]any)
+		if !ok {
+			// A single value is accepted for a list and coerced to a list of one.
+			v.value(path, typ.Elem, value, true, false, rule)
+			return
+		}
+		for i, element := range list {
+			v.value(fmt.Sprintf("%s[%d]", path, i), typ.Elem, element, true, false, rule)
+		}
+		return
+	}
+
+	definition := v.schema.Types[typ.NamedType]
+	if definition == nil {
+		return
+	}
+
+	failed := len(v.errors)
+	switch definition.Kind {
+	case ast.Enum:
+		v.enum(path, definition, value)
+	case ast.InputObject:
+		v.object(path, definition, value)
+	case ast.Scalar:
+		v.scalar(path, definition.Name, value)
+	}
+
+	if check, ok := inputRules[rule]; ok && len(v.errors) == failed {
+		if err := check(value); err != nil {
+			v.fail(path, "%v", err)
+		}
+	}
+}
+
+func (v *validator) enum(path string, definition *ast.Definition, value any) {
+	name, ok := value.(string)
+	options := make([]string, len(definition.EnumValues))
+	for i, enumValue := range definition.EnumValues {
+		options[i] = enumValue.Name
+		if ok && enumValue.Name == name {
+			return
+		}
+	}
+
+	message := fmt.Sprintf("%v is not a valid %s%s", value, definition.Name, suggestion(fmt.Sprint(value), options))
+	if len(options) <= 10 {
+		message += fmt.Sprintf(" (valid values: %s)", strings.Join(options, ", "))
+	}
+	v.fail(path, "%s", message)
+}
+
+func (v *validator) object(path string, definition *ast.Definition, value any) {
+	fields, ok := value.(map[string]any)
+	if !ok {
+		v.fail(path, "expected a %s object", definition.Name)
+		return
+	}
+
+	for _, field := range definition.Fields {
+		fieldValue, present := fields[field.Name]
+		v.value(path+"."+field.Name, field.Type, fieldValue, present, field.DefaultValue != nil, definition.Name+"."+field.Name)
+	}
+
+	var unknown []string
+	for name := range fields {
+		if definition.Fields.ForName(name) == nil {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		v.fail(path+"."+name, "is not a field of %s", definition.Name)
+	}
+}
+
+func (v *validator) scalar(path string, name string, value any) {
+	switch name {
+	case "String":
+		if _, ok := value.(string); !ok {
+			v.fail(path, "expected a string, got %v", value)
+		}
+	case "ID":
+		_, isString := value.(string)
+		_, isNumber := value.(json.Number)
+		if !isString && !isNumber {
+			v.fail(path, "expected an ID, got %v", value)
+		}
+	case "Int":
+		number, ok := value.(json.Number)
+		if _, err := number.Int64(); !ok || err != nil {
+			v.fail(path, "expected an integer, got %v", value)
+		}
+	case "Float":
+		if _, ok := value.(json.Number); !ok {
+			v.fail(path, "expected a number, got %v", value)
+		}
+	case "Boolean":
+		if _, ok := value.(bool); !ok {
+			v.fail(path, "expected true or false, got %v", value)
+		}
+	}
+}
+
+// suggestion returns a hint with the option closest to value, or an empty string when none is close.
+func suggestion(value string, options []string) string {
+	best, bestDistance := "", -1
+	for _, option := range options {
+		if strings.EqualFold(option, value) {
+			return fmt.Sprintf(", did you mean %s?", option)
+		}
+		distance := editDistance(strings.ToUpper(value), strings.ToUpper(option))
+		if bestDistance < 0 || distance < bestDistance {
+			best, bestDistance = option, distance
+		}
+	}
+
+	if best == "" || bestDistance > max(len(value), len(best))/2 {
+		return ""
+	}
+	return fmt.Sprintf(", did you mean %s?", best)
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validContainerCreate() ContainerCreateInput {
+	return ContainerCreateInput{
+		Name:      "web",
+		Namespace: "production",
+		Resources: ContainerResourcesCpu250Ram500,
+		Image:     "nginx:latest",
+		Ports:     []string{"80:80"},
+		Type:      ContainerTypeDefault,
+		Ingresses: []IngressInput{{Port: 80, EnableTLS: true, DomainName: nil, State: StatePresent}},
+	}
+}
+
+func TestValidateRequestAcceptsValidInput(t *testing.T) {
+	err := ValidateRequest(containerCreate_Operation, __containerCreateInput{Input: validContainerCreate()})
+	assert.NoError(t, err)
+
+	err = ValidateRequest(namespaceList_Operation, nil)
+	assert.NoError(t, err)
+}
+
+func TestValidateRequestEnums(t *testing.T) {
+	input := validContainerCreate()
+	input.Resources = "CPU_250_RAM_50"
+	err := ValidateRequest(containerCreate_Operation, __containerCreateInput{Input: input})
+	assert.EqualError(t, err, "invalid input: input.resources: CPU_250_RAM_50 is not a valid ContainerResources, did you mean CPU_250_RAM_500?")
+
+	input = validContainerCreate()
+	port := 8080
+	input.ExternalConnection = &ExternalConnectionInput{Ports: []ExternalConnectionPortInput{
+		{InternalPort: &port, Protocol: "tcp", State: StatePresent, AllowList: []AllowListInput{{Ip: "0.0.0.0/0", State: "present"}}},
+	}}
+	err = ValidateRequest(containerCreate_Operation, __containerCreateInput{Input: input})
+	assert.ErrorContains(t, err, "input.externalConnection.ports[0].protocol: tcp is not a valid Protocol, did you mean TCP? (valid values: TCP, UDP)")
+	assert.ErrorContains(t, err, "input.externalConnection.ports[0].allowList[0].state: present is not a valid State, did you mean PRESENT?")
+}
+
+func TestValidateRequestRules(t *testing.T) {
+	input := validContainerCreate()
+	input.Name = "Web_1"
+	input.Ingresses[0].Port = 70000
+	input.Ingresses[0].Whitelist = []string{"10.0.0.0/8", "10.0.0.300"}
+	err := ValidateRequest(containerCreate_Operation, __containerCreateInput{Input: input})
+
+	errs, ok := err.(ValidationErrors)
+	assert.True(t, ok)
+	paths := make([]string, len(errs))
+	for i, e := range errs {
+		paths[i] = e.Path
+	}
+	assert.Equal(t, []string{"input.name", "input.ingresses[0].port", "input.ingresses[0].whitelist[1]"}, paths)
+}
+
+func TestValidateRequestRequiredFields(t *testing.T) {
+	err := ValidateRequest(messageQueueCreate_Operation, __messageQueueCreateInput{MessageQueueInput: MessageQueueCreateInput{
+		Name:      "queue",
+		Namespace: "production",
+		Plan:      "plan",
+		Spec:      MessageQueueSpecInput{Type: "RabbitMQ", Version: "3.13"},
+	}})
+	assert.EqualError(t, err, "invalid input: messageQueueInput.allowList: is required")
+}
+
+func TestValidateName(t *testing.T) {
+	for _, valid := range []string{"a", "web", "web-1", "1web"} {
+		assert.NoError(t, ValidateName(valid), valid)
+	}
+	for _, invalid := range []string{"", "Web", "web_1", "-web", "web-", "web.example", string(make([]byte, 64))} {
+		assert.Error(t, ValidateName(invalid), invalid)
+	}
+}
+
+func TestValidateDomainName(t *testing.T) {
+	assert.NoError(t, ValidateDomainName("app.example.com"))
+	assert.NoError(t, ValidateDomainName("App.Example.com."))
+	assert.Error(t, ValidateDomainName("localhost"))
+	assert.Error(t, ValidateDomainName("app_1.example.com"))
+}
+
+func TestValidateCidr(t *testing.T) {
+	for _, valid := range []string{"192.168.1.1", "10.0.0.0/24", "0.0.0.0/0", "::/0", "2001:db8::1"} {
+		assert.NoError(t, ValidateCidr(valid), valid)
+	}
+	for _, invalid := range []string{"", "10.0.0.0/33", "10.0.0", "example.com"} {
+		assert.Error(t, ValidateCidr(invalid), invalid)
+	}
+}
+
+func TestValidatePort(t *testing.T) {
+	assert.NoError(t, ValidatePort(1))
+	assert.NoError(t, ValidatePort(65535))
+	assert.Error(t, ValidatePort(0))
+	assert.Error(t, ValidatePort(65536))
+}
+
+func TestValidateSchedule(t *testing.T) {
+	for _, valid := range []string{"* * * * *", "*/5 * * * *", "0 3 * * 1-5", "0 0 1,15 * *", "30 6 * JAN-MAR sun", "@daily"} {
+		assert.NoError(t, ValidateSchedule(valid), valid)
+	}
+	for _, invalid := range []string{"", "* * * *", "60 * * * *", "0 24 * * *", "0 0 0 * *", "*/0 * * * *", "0 0 * * 5-1", "@dialy"} {
+		assert.Error(t, ValidateSchedule(invalid), invalid)
+	}
+
+	assert.ErrorContains(t, ValidateSchedule("@dialy"), "did you mean @daily?")
+}
+
+func TestParseDatabasePermission(t *testing.T) {
+	permission, err := ParseDatabasePermission("read_write")
+	assert.NoError(t, err)
+	assert.Equal(t, DatabasePermissionReadWrite, permission)
+
+	permission, err = ParseDatabasePermission("READ_ONLY")
+	assert.NoError(t, err)
+	assert.Equal(t, DatabasePermissionReadOnly, permission)
+
+	_, err = ParseDatabasePermission("READ_WRTIE")
+	assert.ErrorContains(t, err, "did you mean READ_WRITE?")
+	_, err = ParseDatabasePermission("")
+	assert.Error(t, err)
+}
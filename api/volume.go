@@ -4,8 +4,8 @@ import (
 	"context"
 )
 
-func (client *Client) ListVolumes(namespace string) ([]VolumeResult, error) {
-	volumeResponse, err := volumeList(context.Background(), *client.client, namespace)
+func (client *Client) ListVolumes(ctx context.Context, namespace string) ([]VolumeResult, error) {
+	volumeResponse, err := volumeList(ctx, *client.client, namespace)
 	if err != nil {
 		return []VolumeResult{}, err
 	}
@@ -28,8 +28,8 @@ func (client *Client) ListVolumes(namespace string) ([]VolumeResult, error) {
 	return result, nil
 }
 
-func (client *Client) ListVolumeByName(namespace string, volumeName string) (*VolumeResult, error) {
-	volumes, err := client.ListVolumes(namespace)
+func (client *Client) ListVolumeByName(ctx context.Context, namespace string, volumeName string) (*VolumeResult, error) {
+	volumes, err := client.ListVolumes(ctx, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -48,8 +48,8 @@ func (client *Client) ListVolumeByName(namespace string, volumeName string) (*Vo
 	return volume, nil
 }
 
-func (client *Client) VolumeCreate(input VolumeCreateInput) (VolumeResult, error) {
-	volumeCreateResponse, err := volumeCreate(context.Background(), *client.client, input)
+func (client *Client) VolumeCreate(ctx context.Context, input VolumeCreateInput) (VolumeResult, error) {
+	volumeCreateResponse, err := volumeCreate(ctx, *client.client, input)
 	if err != nil {
 		return VolumeResult{}, err
 	}
@@ -57,17 +57,17 @@ func (client *Client) VolumeCreate(input VolumeCreateInput) (VolumeResult, error
 	return volumeCreateResponse.GetVolumeCreate(), nil
 }
 
-func (client *Client) VolumeIncrease(input VolumeModifyInput) (VolumeResult, error) {
-	volumeIncreaseResponse, err := volumeIncrease(context.Background(), *client.client, input)
+func (client *Client) VolumeIncrease(ctx context.Context, input VolumeModifyInput) (VolumeResult, error) {
+	volumeIncreaseResponse, err := volumeIncrease(ctx, *client.client, input)
 	if err != nil {
-		return VolumeResult{}, nil
+		return VolumeResult{}, err
 	}
 
 	return volumeIncreaseResponse.GetVolumeIncrease(), nil
 }
 
-func (client *Client) VolumeDelete(namespace string, volumeName string) (bool, error) {
-	volumeDeleteResponse, err := volumeDelete(context.Background(), *client.client, namespace, volumeName)
+func (client *Client) VolumeDelete(ctx context.Context, namespace string, volumeName string) (bool, error) {
+	volumeDeleteResponse, err := volumeDelete(ctx, *client.client, namespace, volumeName)
 	if err != nil {
 		return false, err
 	}
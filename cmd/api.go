@@ -0,0 +1,319 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+	"github.com/spf13/cobra"
+)
+
+// readQuery returns the query given as argument, read from a file for @FILE, or read from stdin for - or no argument.
+func readQuery(args []string, stdin io.Reader) (string, error) {
+	source := "-"
+	if len(args) > 0 {
+		source = args[0]
+	}
+
+	var data []byte
+	var err error
+	switch {
+	case source == "-":
+		data, err = io.ReadAll(stdin)
+	case strings.HasPrefix(source, "@"):
+		data, err = os.ReadFile(source[1:])
+	default:
+		data = []byte(source)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	query := strings.TrimSpace(string(data))
+	if query == "" {
+		return "", fmt.Errorf("the query is empty")
+	}
+	return query, nil
+}
+
+// parseFields parses NAME=VALUE pairs, a value of @FILE is replaced by the contents of the file.
+func parseFields(fields []string) (map[string]string, error) {
+	values := map[string]string{}
+	for _, field := range fields {
+		name, value, ok := strings.Cut(field, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid field %q, use NAME=VALUE", field)
+		}
+
+		if strings.HasPrefix(value, "@") {
+			data, err := os.ReadFile(value[1:])
+			if err != nil {
+				return nil, err
+			}
+			value = strings.TrimSuffix(string(data), "\n")
+		}
+		values[strings.TrimPrefix(name, "$")] = value
+	}
+	return values, nil
+}
+
+// selectPath applies a jq style path, such as .namespaces[].name or .namespaces[0], to value.
+// [] selects every element of a list, so the result can hold more than one value.
+func selectPath(value any, path string) ([]any, error) {
+	if !strings.HasPrefix(path, ".") {
+		return nil, fmt.Errorf("path %q must start with '.'", path)
+	}
+
+	values := []any{value}
+	rest := path
+	for rest != "" && rest != "." {
+		var next []any
+		switch {
+		case strings.HasPrefix(rest, "["):
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("missing ']' in path %q", path)
+			}
+			index := rest[1:end]
+			rest = rest[end+1:]
+
+			for _, current := range values {
+				selected, err := selectIndex(current, index)
+				if err != nil {
+					return nil, err
+				}
+				next = append(next, selected...)
+			}
+		case strings.HasPrefix(rest, "."):
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			field := rest[:end]
+			rest = rest[end:]
+			if field == "" {
+				continue
+			}
+
+			for _, current := range values {
+				switch typed := current.(type) {
+				case map[string]any:
+					next = append(next, typed[field])
+				case nil:
+					next = append(next, nil)
+				default:
+					return nil, fmt.Errorf("cannot select field %q of %s", field, jsonKind(current))
+				}
+			}
+		default:
+			return nil, fmt.Errorf("unexpected %q in path %q", rest, path)
+		}
+		values = next
+	}
+
+	return values, nil
+}
+
+func selectIndex(value any, index string) ([]any, error) {
+	if index == "" {
+		switch typed := value.(type) {
+		case []any:
+			return typed, nil
+		case map[string]any:
+			values := make([]any, 0, len(typed))
+			for _, element := range typed {
+				values = append(values, element)
+			}
+			return values, nil
+		case nil:
+			return nil, nil
+		}
+		return nil, fmt.Errorf("cannot iterate over %s", jsonKind(value))
+	}
+
+	n, err := strconv.Atoi(index)
+	if err != nil {
+		return nil, fmt.Errorf("invalid index %q", index)
+	}
+	list, ok := value.([]any)
+	if !ok {
+		if value == nil {
+			return []any{nil}, nil
+		}
+		return nil, fmt.Errorf("cannot index %s", jsonKind(value))
+	}
+	if n < 0 {
+		n += len(list)
+	}
+	if n < 0 || n >= len(list) {
+		return []any{nil}, nil
+	}
+	return []any{list[n]}, nil
+}
+
+func jsonKind(value any) string {
+	switch value.(type) {
+	case map[string]any:
+		return "an object"
+	case []any:
+		return "a list"
+	case string:
+		return "a string"
+	case bool:
+		return "a boolean"
+	}
+	return "a number"
+}
+
+// isEmpty reports whether a value holds no results: null, or a list or object of empty values.
+func isEmpty(value any) bool {
+	switch typed := value.(type) {
+	case nil:
+		return true
+	case []any:
+		for _, element := range typed {
+			if !isEmpty(element) {
+				return false
+			}
+		}
+		return true
+	case map[string]any:
+		for _, element := range typed {
+			if !isEmpty(element) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// writeSelection prints strings as they are and other values as indented JSON, one value at a time.
+func writeSelection(out io.Writer, values []any) error {
+	for _, value := range values {
+		if text, ok := value.(string); ok {
+			fmt.Fprintln(out, text)
+			continue
+		}
+
+		data, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(data))
+	}
+	return nil
+}
+
+var apiCmd = &cobra.Command{
+	Use:   "api [QUERY]",
+	Short: "Send a GraphQL query or mutation to the API",
+	Long: `Send a GraphQL query or mutation to the API with your login.
+
+The query is given as argument, read from a file with @FILE, or read from stdin with - or no argument.
+It is checked against the schema before it is sent. Variables are set with -F NAME=VALUE and are
+converted to the type the query declares, lists and input objects are given as JSON and @FILE reads
+the value from a file.
+
+The data is printed as JSON, errors are printed to stderr. Use --jq to select part of the data, for
+example --jq '.namespaces[].name'.
+
+The API does not page results. --paginate repeats the query with an Int variable counting up by
+--page-step, until the selected data is empty or --max-pages is reached.`,
+	Example: `  nexaa api 'query { namespaces { name } }' --jq '.namespaces[].name'
+  nexaa api 'query($id: String!) { nodeType(id: $id) { id } }' -F id=small
+  nexaa api @locations.graphql`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		fields, _ := cmd.Flags().GetStringArray("field")
+		path, _ := cmd.Flags().GetString("jq")
+		paginate, _ := cmd.Flags().GetString("paginate")
+		paginate = strings.TrimPrefix(paginate, "$")
+		step, _ := cmd.Flags().GetInt("page-step")
+		maxPages, _ := cmd.Flags().GetInt("max-pages")
+
+		query, err := readQuery(args, os.Stdin)
+		if err != nil {
+			log.Fatalf("Failed to read query: %v", err)
+		}
+
+		values, err := parseFields(fields)
+		if err != nil {
+			log.Fatalf("%v", err)
+		}
+		if _, ok := values[paginate]; paginate != "" && !ok {
+			values[paginate] = "0"
+		}
+
+		variables, err := api.QueryVariables(query, values)
+		if err != nil {
+			log.Fatalf("Invalid query: %v", err)
+		}
+
+		page, pages := 0, 1
+		if paginate != "" {
+			var ok bool
+			if page, ok = variables[paginate].(int); !ok {
+				log.Fatalf("--paginate needs an Int variable, $%s is not one", paginate)
+			}
+			pages = maxPages
+		}
+
+		client := api.NewClient()
+		for i := 0; i < pages; i++ {
+			if paginate != "" {
+				variables[paginate] = page
+				page += step
+			}
+
+			response, err := client.Raw(cmd.Context(), query, variables)
+			if err != nil {
+				log.Fatalf("Request failed: %v", err)
+			}
+
+			var data any
+			if len(response.Data) > 0 {
+				if err := json.Unmarshal(response.Data, &data); err != nil {
+					log.Fatalf("Failed to read response: %v", err)
+				}
+			}
+
+			selected := []any{data}
+			if path != "" {
+				if selected, err = selectPath(data, path); err != nil {
+					log.Fatalf("Invalid --jq: %v", err)
+				}
+			}
+
+			if paginate != "" && len(response.Errors) == 0 && isEmpty(selected) {
+				return
+			}
+			if data != nil {
+				if err := writeSelection(os.Stdout, selected); err != nil {
+					log.Fatalf("Failed to write response: %v", err)
+				}
+			}
+
+			if len(response.Errors) > 0 {
+				for _, graphqlError := range response.Errors {
+					fmt.Fprintf(os.Stderr, "error: %v\n", graphqlError)
+				}
+				os.Exit(1)
+			}
+		}
+	},
+}
+
+func init() {
+	apiCmd.Flags().StringArrayP("field", "F", []string{}, "Variable in the form NAME=VALUE, can be repeated")
+	apiCmd.Flags().String("jq", "", "Only print the data selected by a path such as .namespaces[].name")
+	apiCmd.Flags().String("paginate", "", "Repeat the query, counting this Int variable up until no data is returned")
+	apiCmd.Flags().Int("page-step", 1, "Amount to add to the --paginate variable for each page")
+	apiCmd.Flags().Int("max-pages", 100, "Maximum number of times the query is repeated with --paginate")
+}
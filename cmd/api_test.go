@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadQuery(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "query.graphql")
+	if err := os.WriteFile(file, []byte("query { locations { id } }\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		stdin    string
+		expected string
+		err      bool
+	}{
+		{name: "argument", args: []string{"query { namespaces { name } }"}, expected: "query { namespaces { name } }"},
+		{name: "file", args: []string{"@" + file}, expected: "query { locations { id } }"},
+		{name: "stdin without argument", stdin: "  query { account { id } }\n", expected: "query { account { id } }"},
+		{name: "stdin with dash", args: []string{"-"}, stdin: "query { account { id } }", expected: "query { account { id } }"},
+		{name: "empty", args: []string{" "}, err: true},
+		{name: "missing file", args: []string{"@" + file + ".missing"}, err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := readQuery(test.args, strings.NewReader(test.stdin))
+			if (err != nil) != test.err {
+				t.Fatalf("readQuery() error = %v, expected error %v", err, test.err)
+			}
+			if got != test.expected {
+				t.Errorf("readQuery() = %q, expected %q", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestParseFields(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "input.json")
+	if err := os.WriteFile(file, []byte("{\"name\": \"web\"}\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := parseFields([]string{"namespace=production", "$count=3", "query=a=b", "input=@" + file})
+	if err != nil {
+		t.Fatalf("parseFields() unexpected error: %v", err)
+	}
+	expected := map[string]string{"namespace": "production", "count": "3", "query": "a=b", "input": `{"name": "web"}`}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("parseFields() = %v, expected %v", got, expected)
+	}
+
+	for _, invalid := range []string{"namespace", "=value"} {
+		if _, err := parseFields([]string{invalid}); err == nil {
+			t.Errorf("parseFields(%q) expected an error", invalid)
+		}
+	}
+}
+
+func TestSelectPath(t *testing.T) {
+	t.Parallel()
+
+	var data any
+	if err := json.Unmarshal([]byte(`{"namespaces": [{"name": "production", "containers": [{"name": "web"}]}, {"name": "staging", "containers": []}]}`), &data); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		path     string
+		expected string
+		err      bool
+	}{
+		{path: ".", expected: `[{"namespaces":[{"containers":[{"name":"web"}],"name":"production"},{"containers":[],"name":"staging"}]}]`},
+		{path: ".namespaces[].name", expected: `["production","staging"]`},
+		{path: ".namespaces[0].containers[].name", expected: `["web"]`},
+		{path: ".namespaces[-1].name", expected: `["staging"]`},
+		{path: ".namespaces[5].name", expected: `[null]`},
+		{path: ".missing.name", expected: `[null]`},
+		{path: ".namespaces[].containers[].name", expected: `["web"]`},
+		{path: "namespaces", err: true},
+		{path: ".namespaces.name", err: true},
+		{path: ".namespaces[0", err: true},
+		{path: ".namespaces[x]", err: true},
+		{path: ".namespaces[0].name[]", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := selectPath(data, test.path)
+			if (err != nil) != test.err {
+				t.Fatalf("selectPath() error = %v, expected error %v", err, test.err)
+			}
+			if test.err {
+				return
+			}
+			encoded, _ := json.Marshal(got)
+			if string(encoded) != test.expected {
+				t.Errorf("selectPath() = %s, expected %s", encoded, test.expected)
+			}
+		})
+	}
+}
+
+func TestIsEmpty(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		data     string
+		expected bool
+	}{
+		{data: `null`, expected: true},
+		{data: `[]`, expected: true},
+		{data: `{"volumes": []}`, expected: true},
+		{data: `[null, {"volumes": null}]`, expected: true},
+		{data: `{"volumes": [{"name": "data"}]}`, expected: false},
+		{data: `""`, expected: false},
+		{data: `0`, expected: false},
+	}
+
+	for _, test := range tests {
+		var value any
+		if err := json.Unmarshal([]byte(test.data), &value); err != nil {
+			t.Fatal(err)
+		}
+		if got := isEmpty(value); got != test.expected {
+			t.Errorf("isEmpty(%s) = %v, expected %v", test.data, got, test.expected)
+		}
+	}
+}
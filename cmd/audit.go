@@ -0,0 +1,438 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+	"github.com/spf13/cobra"
+)
+
+// auditTimeLayouts are the layouts accepted for occurredAt and for --since and --until.
+var auditTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+var auditCsvHeader = []string{"id", "occurred_at", "actor", "actor_email", "event_type", "model_name", "model_id", "field", "old_value", "new_value"}
+
+// auditChange is a single field change in the exported formats.
+type auditChange struct {
+	Field    string  `json:"field"`
+	OldValue *string `json:"oldValue"`
+	NewValue *string `json:"newValue"`
+}
+
+// auditRecord is an audit log entry in the exported formats.
+type auditRecord struct {
+	Id         string        `json:"id"`
+	OccurredAt string        `json:"occurredAt"`
+	Actor      string        `json:"actor"`
+	ActorEmail string        `json:"actorEmail"`
+	EventType  string        `json:"eventType"`
+	ModelName  string        `json:"modelName"`
+	ModelId    int           `json:"modelId"`
+	Tags       []string      `json:"tags"`
+	Changes    []auditChange `json:"changes"`
+}
+
+// auditFilter holds the filters, they are all applied locally. Event types and model names are also sent
+// to the API, see apiFilter.
+type auditFilter struct {
+	since      time.Time
+	until      time.Time
+	actor      string
+	eventTypes []string
+	modelNames []string
+}
+
+// apiFilter returns the filter sent to the API. The API requires both lists, and an empty list matches
+// no entries, so when only one of them is set nothing is sent and the entries are filtered locally.
+func (filter auditFilter) apiFilter() *api.AuditLogFilterInput {
+	if len(filter.eventTypes) == 0 || len(filter.modelNames) == 0 {
+		return nil
+	}
+	return &api.AuditLogFilterInput{EventTypes: filter.eventTypes, ModelNames: filter.modelNames}
+}
+
+// auditCursor remembers which entries were printed while following. Instead of the id of every entry it
+// keeps the time of the newest entry and the ids of the entries at that time, entries without a valid
+// time are remembered by id.
+type auditCursor struct {
+	newest  time.Time
+	ids     map[string]bool
+	undated map[string]bool
+}
+
+func newAuditCursor() *auditCursor {
+	return &auditCursor{ids: map[string]bool{}, undated: map[string]bool{}}
+}
+
+func (cursor *auditCursor) seen(entry api.AuditLogResult) bool {
+	occurredAt, ok := parseAuditTime(entry.OccurredAt)
+	if !ok {
+		return cursor.undated[entry.Id]
+	}
+	return occurredAt.Before(cursor.newest) || (occurredAt.Equal(cursor.newest) && cursor.ids[entry.Id])
+}
+
+func (cursor *auditCursor) mark(entry api.AuditLogResult) {
+	occurredAt, ok := parseAuditTime(entry.OccurredAt)
+	switch {
+	case !ok:
+		cursor.undated[entry.Id] = true
+	case occurredAt.After(cursor.newest):
+		cursor.newest = occurredAt
+		cursor.ids = map[string]bool{entry.Id: true}
+	case occurredAt.Equal(cursor.newest):
+		cursor.ids[entry.Id] = true
+	}
+}
+
+func parseAuditTime(value string) (time.Time, bool) {
+	for _, layout := range auditTimeLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseTimeFlag accepts a point in time or a duration such as 24h, which is taken relative to now.
+func parseTimeFlag(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if duration, err := time.ParseDuration(value); err == nil {
+		return now.Add(-duration), nil
+	}
+	if parsed, ok := parseAuditTime(value); ok {
+		return parsed, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q, use a duration such as 24h or a date such as 2026-01-31 or 2026-01-31T12:00:00Z", value)
+}
+
+func toAuditRecord(entry api.AuditLogResult) auditRecord {
+	record := auditRecord{
+		Id:         entry.Id,
+		OccurredAt: entry.OccurredAt,
+		EventType:  entry.EventType,
+		ModelName:  entry.ModelName,
+		ModelId:    entry.ModelId,
+		Tags:       entry.Tags,
+		Changes:    []auditChange{},
+	}
+	if record.Tags == nil {
+		record.Tags = []string{}
+	}
+	if entry.Account != nil {
+		record.Actor = entry.Account.Name
+		record.ActorEmail = entry.Account.Email
+	}
+	for _, change := range entry.ChangeSet {
+		record.Changes = append(record.Changes, auditChange{Field: change.Name, OldValue: change.OldValue, NewValue: change.NewValue})
+	}
+	return record
+}
+
+// matches reports whether the record falls in the time range, has one of the event types and models and was
+// made by the actor.
+// Entries with an unparsable time are kept, so nothing is silently lost from an export.
+func (filter auditFilter) matches(record auditRecord) bool {
+	if occurredAt, ok := parseAuditTime(record.OccurredAt); ok {
+		if !filter.since.IsZero() && occurredAt.Before(filter.since) {
+			return false
+		}
+		if !filter.until.IsZero() && occurredAt.After(filter.until) {
+			return false
+		}
+	}
+
+	if len(filter.eventTypes) > 0 && !slices.Contains(filter.eventTypes, record.EventType) {
+		return false
+	}
+	if len(filter.modelNames) > 0 && !slices.Contains(filter.modelNames, record.ModelName) {
+		return false
+	}
+
+	if filter.actor != "" {
+		actor := strings.ToLower(filter.actor)
+		if !strings.Contains(strings.ToLower(record.Actor), actor) && !strings.Contains(strings.ToLower(record.ActorEmail), actor) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newAuditRecords converts, filters and sorts the entries the cursor has not seen before, oldest first.
+func newAuditRecords(entries []api.AuditLogResult, filter auditFilter, cursor *auditCursor) []auditRecord {
+	var unseen []api.AuditLogResult
+	for _, entry := range entries {
+		if !cursor.seen(entry) {
+			unseen = append(unseen, entry)
+		}
+	}
+
+	var records []auditRecord
+	for _, entry := range unseen {
+		cursor.mark(entry)
+
+		record := toAuditRecord(entry)
+		if filter.matches(record) {
+			records = append(records, record)
+		}
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		left, _ := parseAuditTime(records[i].OccurredAt)
+		right, _ := parseAuditTime(records[j].OccurredAt)
+		return left.Before(right)
+	})
+	return records
+}
+
+func auditValue(value *string) string {
+	if value == nil {
+		return "-"
+	}
+	return strconv.Quote(*value)
+}
+
+func auditActor(record auditRecord) string {
+	switch {
+	case record.ActorEmail != "":
+		return record.ActorEmail
+	case record.Actor != "":
+		return record.Actor
+	}
+	return "system"
+}
+
+func writeAuditTable(out io.Writer, records []auditRecord, header bool) {
+	writer := tabwriter.NewWriter(out, 0, 0, 3, ' ', tabwriter.Debug)
+	if header {
+		fmt.Fprintln(writer, "OCCURRED AT\t ACTOR\t EVENT\t MODEL\t CHANGES\t")
+	}
+
+	for _, record := range records {
+		model := fmt.Sprintf("%s/%d", record.ModelName, record.ModelId)
+		changes := make([]string, 0, len(record.Changes))
+		for _, change := range record.Changes {
+			changes = append(changes, fmt.Sprintf("%s: %s → %s", change.Field, auditValue(change.OldValue), auditValue(change.NewValue)))
+		}
+		if len(changes) == 0 {
+			changes = append(changes, "")
+		}
+
+		fmt.Fprintf(writer, "%s\t %s\t %s\t %s\t %s\t\n", record.OccurredAt, auditActor(record), record.EventType, model, changes[0])
+		for _, change := range changes[1:] {
+			fmt.Fprintf(writer, "\t \t \t \t %s\t\n", change)
+		}
+	}
+
+	writer.Flush()
+}
+
+func writeAuditJsonLines(out io.Writer, records []auditRecord) error {
+	encoder := json.NewEncoder(out)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeAuditCsv writes one row per changed field so the export can be filtered on fields in a spreadsheet.
+func writeAuditCsv(out io.Writer, records []auditRecord, header bool) error {
+	writer := csv.NewWriter(out)
+	if header {
+		if err := writer.Write(auditCsvHeader); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range records {
+		row := []string{record.Id, record.OccurredAt, record.Actor, record.ActorEmail, record.EventType, record.ModelName, strconv.Itoa(record.ModelId)}
+		if len(record.Changes) == 0 {
+			if err := writer.Write(append(row, "", "", "")); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, change := range record.Changes {
+			values := []string{change.Field, "", ""}
+			if change.OldValue != nil {
+				values[1] = *change.OldValue
+			}
+			if change.NewValue != nil {
+				values[2] = *change.NewValue
+			}
+			if err := writer.Write(append(append([]string{}, row...), values...)); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func writeAuditRecords(out io.Writer, output string, records []auditRecord, header bool) error {
+	switch output {
+	case "table":
+		writeAuditTable(out, records, header)
+		return nil
+	case "jsonl":
+		return writeAuditJsonLines(out, records)
+	case "csv":
+		return writeAuditCsv(out, records, header)
+	}
+	return fmt.Errorf("unknown output %q, use one of: table, jsonl, csv", output)
+}
+
+// isStreamedAuditOutput reports whether output is written by audit itself. jsonl and csv are only offered by
+// audit, as they suit exports, the other formats are the ones of every list command.
+func isStreamedAuditOutput(output string) bool {
+	return output == "table" || output == "jsonl" || output == "csv"
+}
+
+// checkAuditFlags returns an error for an unknown --output, or for --follow with an output or --interval it
+// cannot poll with.
+func checkAuditFlags(output string, follow bool, interval time.Duration) error {
+	if !isStreamedAuditOutput(output) {
+		if _, err := parseOutputFormat(output); err != nil {
+			return fmt.Errorf("invalid --output: %w", err)
+		}
+		if follow {
+			return fmt.Errorf("--follow only supports the table, jsonl and csv outputs")
+		}
+	}
+	if follow && interval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+	return nil
+}
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Inspect the audit log of your account",
+}
+
+var listAuditCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List audit log entries",
+	Long: `List audit log entries, oldest first.
+
+Event types and models are filtered by the API when both are passed, everything else is filtered locally.
+Use --output jsonl or --output csv to export the entries, csv contains one row per changed field.
+The json, go-template, jsonpath and custom-columns outputs work like they do for the other list commands.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		eventTypes, _ := cmd.Flags().GetStringArray("event-type")
+		models, _ := cmd.Flags().GetStringArray("model")
+		since, _ := cmd.Flags().GetString("since")
+		until, _ := cmd.Flags().GetString("until")
+		actor, _ := cmd.Flags().GetString("actor")
+		output, _ := cmd.Flags().GetString("output")
+		follow, _ := cmd.Flags().GetBool("follow")
+		interval, _ := cmd.Flags().GetDuration("interval")
+
+		if err := checkAuditFlags(output, follow, interval); err != nil {
+			log.Fatalf("%v", err)
+		}
+		streamed := isStreamedAuditOutput(output)
+
+		now := time.Now()
+		filter := auditFilter{actor: actor, eventTypes: eventTypes, modelNames: models}
+		var err error
+		if filter.since, err = parseTimeFlag(since, now); err != nil {
+			log.Fatalf("Invalid --since: %v", err)
+		}
+		if filter.until, err = parseTimeFlag(until, now); err != nil {
+			log.Fatalf("Invalid --until: %v", err)
+		}
+		if follow && !filter.until.IsZero() {
+			log.Fatalf("--follow cannot be combined with --until")
+		}
+
+		client := api.NewClient()
+		customerId, err := client.GetAccountId(cmd.Context())
+		if err != nil {
+			log.Fatalf("Failed to get account: %v", err)
+		}
+
+		cursor := newAuditCursor()
+
+		entries, err := client.AuditLogs(cmd.Context(), customerId, filter.apiFilter())
+		if err != nil {
+			log.Fatalf("Failed to list audit logs: %v", err)
+		}
+
+		records := newAuditRecords(entries, filter, cursor)
+		if !streamed {
+			printOutput(cmd, records)
+			return
+		}
+		if len(records) == 0 && !follow && output == "table" {
+			fmt.Println("No audit log entries found.")
+			return
+		}
+		if err := writeAuditRecords(os.Stdout, output, records, true); err != nil {
+			log.Fatalf("Failed to write audit logs: %v", err)
+		}
+
+		if !follow {
+			return
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+		defer stop()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			entries, err := client.AuditLogs(ctx, customerId, filter.apiFilter())
+			if err != nil {
+				log.Printf("Failed to list audit logs: %v", err)
+				continue
+			}
+
+			if err := writeAuditRecords(os.Stdout, output, newAuditRecords(entries, filter, cursor), false); err != nil {
+				log.Fatalf("Failed to write audit logs: %v", err)
+			}
+		}
+	},
+}
+
+func init() {
+	listAuditCmd.Flags().StringArray("event-type", []string{}, "Only show entries with this event type, can be repeated")
+	listAuditCmd.Flags().StringArray("model", []string{}, "Only show entries for this model name, can be repeated")
+	listAuditCmd.Flags().String("since", "", "Only show entries after this time or duration ago (e.g. 24h or 2026-01-31)")
+	listAuditCmd.Flags().String("until", "", "Only show entries before this time or duration ago")
+	listAuditCmd.Flags().String("actor", "", "Only show entries made by an account whose name or email contains this value")
+	listAuditCmd.Flags().StringP("output", "o", "table", outputUsage+", jsonl or csv")
+	listAuditCmd.Flags().BoolP("follow", "f", false, "Keep polling and print new entries as they occur")
+	listAuditCmd.Flags().Duration("interval", 10*time.Second, "Time between polls when following")
+	auditCmd.AddCommand(listAuditCmd)
+}
@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+func TestParseTimeFlag(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value    string
+		expected time.Time
+		wantErr  bool
+	}{
+		{value: "", expected: time.Time{}},
+		{value: "24h", expected: now.Add(-24 * time.Hour)},
+		{value: "2026-10-01", expected: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{value: "2026-10-01T08:30:00Z", expected: time.Date(2026, 10, 1, 8, 30, 0, 0, time.UTC)},
+		{value: "yesterday", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseTimeFlag(tt.value, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseTimeFlag(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("parseTimeFlag(%q) = %v, expected %v", tt.value, got, tt.expected)
+			}
+		})
+	}
+}
+
+func auditEntry(id string, occurredAt string, email string) api.AuditLogResult {
+	return api.AuditLogResult{
+		Id:         id,
+		OccurredAt: occurredAt,
+		EventType:  "update",
+		ModelName:  "Container",
+		ModelId:    7,
+		Account:    &api.AuditLogResultAccount{Name: "Jane", Email: email},
+	}
+}
+
+func TestNewAuditRecords(t *testing.T) {
+	t.Parallel()
+
+	entries := []api.AuditLogResult{
+		auditEntry("3", "2026-10-18T10:00:00Z", "ops@example.com"),
+		auditEntry("1", "2026-10-10T10:00:00Z", "ops@example.com"),
+		auditEntry("2", "2026-10-17T10:00:00Z", "dev@example.com"),
+		auditEntry("4", "not a date", "ops@example.com"),
+	}
+	entries[2].EventType = "create"
+	entries[2].ModelName = "Volume"
+
+	tests := []struct {
+		name     string
+		filter   auditFilter
+		seen     []int
+		expected []string
+	}{
+		{name: "all entries oldest first", expected: []string{"4", "1", "2", "3"}},
+		{name: "since", filter: auditFilter{since: time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)}, expected: []string{"4", "2", "3"}},
+		{name: "until", filter: auditFilter{until: time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)}, expected: []string{"4", "1"}},
+		{name: "actor", filter: auditFilter{actor: "DEV@"}, expected: []string{"2"}},
+		{name: "only event types", filter: auditFilter{eventTypes: []string{"create"}}, expected: []string{"2"}},
+		{name: "only models", filter: auditFilter{modelNames: []string{"Container"}}, expected: []string{"4", "1", "3"}},
+		{name: "already seen entries", seen: []int{1, 2, 3}, expected: []string{"3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cursor := newAuditCursor()
+			for _, i := range tt.seen {
+				cursor.mark(entries[i])
+			}
+
+			var got []string
+			for _, record := range newAuditRecords(entries, tt.filter, cursor) {
+				got = append(got, record.Id)
+			}
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("newAuditRecords() = %v, expected %v", got, tt.expected)
+			}
+			if again := newAuditRecords(entries, tt.filter, cursor); len(again) != 0 {
+				t.Errorf("newAuditRecords() returned %d entries again", len(again))
+			}
+			if len(cursor.ids) != 1 {
+				t.Errorf("cursor keeps %d ids, expected only the newest entry", len(cursor.ids))
+			}
+		})
+	}
+}
+
+func TestAuditApiFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		filter   auditFilter
+		expected *api.AuditLogFilterInput
+	}{
+		{name: "no filter"},
+		{name: "only event types", filter: auditFilter{eventTypes: []string{"create"}}},
+		{name: "only models", filter: auditFilter{modelNames: []string{"Container"}}},
+		{
+			name:     "event types and models",
+			filter:   auditFilter{eventTypes: []string{"create"}, modelNames: []string{"Container"}},
+			expected: &api.AuditLogFilterInput{EventTypes: []string{"create"}, ModelNames: []string{"Container"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tt.filter.apiFilter()
+			if (got == nil) != (tt.expected == nil) || (got != nil && (!slices.Equal(got.EventTypes, tt.expected.EventTypes) || !slices.Equal(got.ModelNames, tt.expected.ModelNames))) {
+				t.Errorf("apiFilter() = %+v, expected %+v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCheckAuditFlags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		output   string
+		follow   bool
+		interval time.Duration
+		wantErr  bool
+	}{
+		{name: "table", output: "table", interval: 10 * time.Second},
+		{name: "follow csv", output: "csv", follow: true, interval: time.Second},
+		{name: "json without follow", output: "json", interval: 10 * time.Second},
+		{name: "unknown output", output: "yaml", wantErr: true},
+		{name: "follow json", output: "json", follow: true, interval: time.Second, wantErr: true},
+		{name: "follow with zero interval", output: "table", follow: true, wantErr: true},
+		{name: "follow with negative interval", output: "jsonl", follow: true, interval: -time.Second, wantErr: true},
+		{name: "zero interval without follow", output: "table"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := checkAuditFlags(tt.output, tt.follow, tt.interval); (err != nil) != tt.wantErr {
+				t.Errorf("checkAuditFlags(%q, %v, %s) error = %v, wantErr %v", tt.output, tt.follow, tt.interval, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWriteAuditRecords(t *testing.T) {
+	t.Parallel()
+
+	old := "1"
+	updated := "3"
+	entry := auditEntry("1", "2026-10-18T10:00:00Z", "ops@example.com")
+	entry.ChangeSet = []api.AuditLogResultChangeSetAuditLogChange{
+		{Name: "replicas", OldValue: &old, NewValue: &updated},
+		{Name: "image", NewValue: &updated},
+	}
+	records := []auditRecord{toAuditRecord(entry), toAuditRecord(auditEntry("2", "2026-10-18T11:00:00Z", ""))}
+
+	tests := []struct {
+		output   string
+		expected string
+	}{
+		{
+			output: "csv",
+			expected: "id,occurred_at,actor,actor_email,event_type,model_name,model_id,field,old_value,new_value\n" +
+				"1,2026-10-18T10:00:00Z,Jane,ops@example.com,update,Container,7,replicas,1,3\n" +
+				"1,2026-10-18T10:00:00Z,Jane,ops@example.com,update,Container,7,image,,3\n" +
+				"2,2026-10-18T11:00:00Z,Jane,,update,Container,7,,,\n",
+		},
+		{
+			output: "jsonl",
+			expected: `{"id":"1","occurredAt":"2026-10-18T10:00:00Z","actor":"Jane","actorEmail":"ops@example.com","eventType":"update","modelName":"Container","modelId":7,"tags":[],"changes":[{"field":"replicas","oldValue":"1","newValue":"3"},{"field":"image","oldValue":null,"newValue":"3"}]}` + "\n" +
+				`{"id":"2","occurredAt":"2026-10-18T11:00:00Z","actor":"Jane","actorEmail":"","eventType":"update","modelName":"Container","modelId":7,"tags":[],"changes":[]}` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.output, func(t *testing.T) {
+			t.Parallel()
+
+			var out bytes.Buffer
+			if err := writeAuditRecords(&out, tt.output, records, true); err != nil {
+				t.Fatalf("writeAuditRecords() error = %v", err)
+			}
+			if out.String() != tt.expected {
+				t.Errorf("writeAuditRecords() =\n%s\nexpected\n%s", out.String(), tt.expected)
+			}
+		})
+	}
+
+	if err := writeAuditRecords(&bytes.Buffer{}, "xml", records, true); err == nil {
+		t.Errorf("writeAuditRecords() with an unknown output returned no error")
+	}
+}
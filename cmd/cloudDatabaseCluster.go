@@ -23,22 +23,26 @@ var createCloudDatabaseClusterCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		namespace, _ := cmd.Flags().GetString("namespace")
 		name, _ := cmd.Flags().GetString("name")
-		planID, _ := cmd.Flags().GetString("plan")
 		version, _ := cmd.Flags().GetString("version")
 		dbType, _ := cmd.Flags().GetString("type")
 
+		client := api.NewClient()
+		plans, err := client.CloudDatabaseClusterListPlans(cmd.Context())
+		if err != nil {
+			log.Fatalf("Failed to list cloud database cluster plans: %v", err)
+		}
+
 		input := api.CloudDatabaseClusterCreateInput{
 			Name:      name,
 			Namespace: namespace,
-			Plan:      planID,
+			Plan:      planFromFlags(cmd, databasePlans(plans)),
 			Spec: api.CloudDatabaseClusterSpecInput{
 				Type:    dbType,
 				Version: version,
 			},
 		}
 
-		client := api.NewClient()
-		result, err := client.CloudDatabaseClusterCreate(input)
+		result, err := client.CloudDatabaseClusterCreate(cmd.Context(), input)
 		if err != nil {
 			log.Fatalf("Failed to create cloud database cluster: %v", err)
 			return
@@ -52,10 +56,14 @@ var listCloudDatabaseClustersCmd = &cobra.Command{
 	Short: "List all cloud database clusters",
 	Run: func(cmd *cobra.Command, args []string) {
 		client := api.NewClient()
-		clusters, err := client.CloudDatabaseClusterList()
+		clusters, err := client.CloudDatabaseClusterList(cmd.Context())
 		if err != nil {
 			log.Fatalf("Failed to list cloud database clusters: %v", err)
 		}
+
+		if printOutput(cmd, clusters) {
+			return
+		}
 		if len(clusters) == 0 {
 			fmt.Println("No cloud database clusters found.")
 			return
@@ -109,11 +117,15 @@ var listCloudDatabaseClusterCmd = &cobra.Command{
 			Namespace: namespace,
 			Name:      name,
 		}
-		cluster, err := client.CloudDatabaseClusterGet(input)
+		cluster, err := client.CloudDatabaseClusterGet(cmd.Context(), input)
 		if err != nil {
 			log.Fatalf("Failed to get cloud database cluster: %v", err)
 			return
 		}
+
+		if printOutput(cmd, cluster) {
+			return
+		}
 		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.Debug)
 		DatabaseNames := getDatabaseNames(cluster.Databases)
 		Usernames := getUsernames(cluster.Users)
@@ -135,7 +147,24 @@ var deleteCloudDatabaseClusterCmd = &cobra.Command{
 			Namespace: namespace,
 			Name:      name,
 		}
-		result, err := client.CloudDatabaseClusterDelete(input)
+
+		cluster, err := client.CloudDatabaseClusterGet(cmd.Context(), input)
+		if err != nil {
+			log.Fatalf("Failed to get cloud database cluster: %v", err)
+		}
+		containers, err := client.ListContainers(cmd.Context(), namespace)
+		if err != nil {
+			log.Fatalf("Failed to list containers: %v", err)
+		}
+		jobs, err := client.ContainerJobList(cmd.Context(), namespace)
+		if err != nil {
+			log.Fatalf("Failed to list container jobs: %v", err)
+		}
+		refuseWithDependents("cloud database cluster", name, hostnameDependents(cluster.Hostname, containers, jobs), false)
+
+		confirmDeletion(cmd, "cloud database cluster", name)
+
+		result, err := client.CloudDatabaseClusterDelete(cmd.Context(), input)
 		if err != nil {
 			log.Fatalf("Failed to delete cloud database cluster: %v", err)
 			return
@@ -152,18 +181,23 @@ var listCloudDatabaseClusterPlansCmd = &cobra.Command{
 	Short: "List available plans for cloud database clusters",
 	Run: func(cmd *cobra.Command, args []string) {
 		client := api.NewClient()
-		plans, err := client.CloudDatabaseClusterListPlans()
+		plans, err := client.CloudDatabaseClusterListPlans(cmd.Context())
 		if err != nil {
 			log.Fatalf("Failed to list cloud database cluster plans: %v", err)
 		}
-		if len(plans) == 0 {
+		choices := filterPlans(databasePlans(plans), planRequirementsFromFlags(cmd))
+		rankPlans(choices)
+		if printOutput(cmd, choices) {
+			return
+		}
+		if len(choices) == 0 {
 			fmt.Println("No cloud database cluster plans found.")
 			return
 		}
 		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.Debug)
-		fmt.Fprintln(writer, "ID\tNAME\tCPU\tSTORAGE\tRAM\tCURRENCY\tPRICE\t")
-		for _, p := range plans {
-			fmt.Fprintf(writer, "%s\t%s\t%d\t%dGB\t%dGB\t%s\t%.2f\t\n", p.Id, p.Name, p.Cpu, p.Storage, int(p.Memory), *p.Price.Currency, float64(*p.Price.Amount)/100)
+		fmt.Fprintln(writer, "ID\tNAME\tCPU\tSTORAGE\tRAM\tPRICE\t")
+		for _, p := range choices {
+			fmt.Fprintf(writer, "%s\t%s\t%g\t%gGB\t%gGB\t%s\t\n", p.Id, p.Name, p.Cpu, p.Storage, p.Memory, formatPlanPrice(p))
 		}
 		writer.Flush()
 	},
@@ -174,10 +208,14 @@ var listCloudDatabaseClusterSpecsCmd = &cobra.Command{
 	Short: "List available specs for cloud database clusters",
 	Run: func(cmd *cobra.Command, args []string) {
 		client := api.NewClient()
-		specs, err := client.CloudDatabaseClusterListSpecs()
+		specs, err := client.CloudDatabaseClusterListSpecs(cmd.Context())
 		if err != nil {
 			log.Fatalf("Failed to list cloud database cluster specs: %v", err)
 		}
+
+		if printOutput(cmd, specs) {
+			return
+		}
 		if len(specs) == 0 {
 			fmt.Println("No cloud database cluster specs found.")
 			return
@@ -203,43 +241,90 @@ var getClusterDatabaseUserCredentialsCmd = &cobra.Command{
 			Namespace: namespace,
 		}
 		client := api.NewClient()
-		dsn, err := client.CloudDatabaseClusterUserCredentials(input, userName)
+		credentials, err := client.CloudDatabaseClusterUserCredentialsGet(cmd.Context(), input, userName)
 		if err != nil {
 			log.Fatalf("Failed to get user credentials: %v", err)
 			return
 		}
-		fmt.Printf("DSN for user %s: %s\n", userName, dsn)
+
+		if printOutput(cmd, credentials) {
+			return
+		}
+
+		fmt.Printf("DSN for user %s: %s\n", userName, credentials.Dsn)
+	},
+}
+
+var listCloudDatabaseClusterFeaturesCmd = &cobra.Command{
+	Use:   "features",
+	Short: "List the features of a cloud database cluster",
+	Long: `List the opt-in features of a cloud database cluster, such as backups, with their status.
+The API does not return the name of a feature yet, so only their state is shown.
+
+Features can not be enabled or disabled from the CLI yet, as the API does not offer a mutation for it.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		namespace, _ := cmd.Flags().GetString("namespace")
+		clusterName, _ := cmd.Flags().GetString("cluster")
+		client := api.NewClient()
+		input := api.CloudDatabaseClusterResourceInput{
+			Namespace: namespace,
+			Name:      clusterName,
+		}
+		features, err := client.CloudDatabaseClusterFeatures(cmd.Context(), input)
+		if err != nil {
+			log.Fatalf("Failed to list cloud database cluster features: %v", err)
+		}
+		if printOutput(cmd, features) {
+			return
+		}
+		if len(features) == 0 {
+			fmt.Println("No features found for this cloud database cluster.")
+			return
+		}
+		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.Debug)
+		fmt.Fprintln(writer, "ENABLED\tSTATUS\t")
+		for _, f := range features {
+			fmt.Fprintf(writer, "%s\t%s\t\n", enabledApiToString(f.Enabled), f.Status)
+		}
+		writer.Flush()
 	},
 }
 
 func init() {
 	createCloudDatabaseClusterCmd.Flags().StringP("namespace", "n", "", "Namespace")
 	createCloudDatabaseClusterCmd.Flags().String("name", "", "Name for this cluster")
-	createCloudDatabaseClusterCmd.Flags().String("plan", "", "ID of the plan to use for this cluster")
+	createCloudDatabaseClusterCmd.Flags().String("plan", "", "ID or name of the plan to use for this cluster, or use the requirement flags to pick the cheapest plan that fits")
+	addPlanRequirementFlags(createCloudDatabaseClusterCmd)
 	createCloudDatabaseClusterCmd.Flags().String("type", "", "Type of the cluster (e.g., 'postgresql', 'mysql')")
 	createCloudDatabaseClusterCmd.Flags().String("version", "", "Version of the database engine (e.g., '14', '15')")
 	createCloudDatabaseClusterCmd.MarkFlagRequired("namespace")
 	createCloudDatabaseClusterCmd.MarkFlagRequired("name")
-	createCloudDatabaseClusterCmd.MarkFlagRequired("plan ID")
+	markPlanFlagsOneRequired(createCloudDatabaseClusterCmd)
 	createCloudDatabaseClusterCmd.MarkFlagRequired("type")
 	createCloudDatabaseClusterCmd.MarkFlagRequired("version")
 	cloudDatabaseClusterCmd.AddCommand(createCloudDatabaseClusterCmd)
 
+	addOutputFlag(listCloudDatabaseClustersCmd)
 	cloudDatabaseClusterCmd.AddCommand(listCloudDatabaseClustersCmd)
 
 	deleteCloudDatabaseClusterCmd.Flags().StringP("namespace", "n", "", "Namespace")
 	deleteCloudDatabaseClusterCmd.Flags().String("name", "", "Name of the cluster")
+	addConfirmFlag(deleteCloudDatabaseClusterCmd)
 	deleteCloudDatabaseClusterCmd.MarkFlagRequired("namespace")
 	deleteCloudDatabaseClusterCmd.MarkFlagRequired("name")
 	cloudDatabaseClusterCmd.AddCommand(deleteCloudDatabaseClusterCmd)
 
+	addPlanRequirementFlags(listCloudDatabaseClusterPlansCmd)
+	addOutputFlag(listCloudDatabaseClusterPlansCmd)
 	cloudDatabaseClusterCmd.AddCommand(listCloudDatabaseClusterPlansCmd)
+	addOutputFlag(listCloudDatabaseClusterSpecsCmd)
 	cloudDatabaseClusterCmd.AddCommand(listCloudDatabaseClusterSpecsCmd)
 
 	listCloudDatabaseClusterCmd.Flags().StringP("namespace", "n", "", "Namespace name")
 	listCloudDatabaseClusterCmd.Flags().String("name", "", "Name of the cluster")
 	listCloudDatabaseClusterCmd.MarkFlagRequired("namespace")
 	listCloudDatabaseClusterCmd.MarkFlagRequired("name")
+	addOutputFlag(listCloudDatabaseClusterCmd)
 	cloudDatabaseClusterCmd.AddCommand(listCloudDatabaseClusterCmd)
 
 	getClusterDatabaseUserCredentialsCmd.Flags().String("cluster", "", "Cluster name")
@@ -248,8 +333,16 @@ func init() {
 	getClusterDatabaseUserCredentialsCmd.MarkFlagRequired("cluster")
 	getClusterDatabaseUserCredentialsCmd.MarkFlagRequired("namespace")
 	getClusterDatabaseUserCredentialsCmd.MarkFlagRequired("user")
+	addOutputFlag(getClusterDatabaseUserCredentialsCmd)
 	cloudDatabaseClusterCmd.AddCommand(getClusterDatabaseUserCredentialsCmd)
 
+	listCloudDatabaseClusterFeaturesCmd.Flags().StringP("namespace", "n", "", "Namespace")
+	listCloudDatabaseClusterFeaturesCmd.Flags().String("cluster", "", "Name of the cluster")
+	listCloudDatabaseClusterFeaturesCmd.MarkFlagRequired("namespace")
+	listCloudDatabaseClusterFeaturesCmd.MarkFlagRequired("cluster")
+	addOutputFlag(listCloudDatabaseClusterFeaturesCmd)
+	cloudDatabaseClusterCmd.AddCommand(listCloudDatabaseClusterFeaturesCmd)
+
 	//External Connection
 	cloudDatabaseClusterCmd.AddCommand(cloudDatabaseClusterEnableExternalConnectionCmd)
 }
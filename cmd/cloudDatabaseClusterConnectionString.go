@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+	"github.com/spf13/cobra"
+)
+
+const (
+	enginePostgres = "postgresql"
+	engineMysql    = "mysql"
+)
+
+type connectionDetails struct {
+	Type     string
+	Host     string
+	Port     int
+	User     string
+	Password string
+	Database string
+}
+
+// databaseEngine normalizes the spec type of a cluster to the engine used in connection strings.
+func databaseEngine(specType string) (string, error) {
+	specType = strings.ToLower(specType)
+	switch {
+	case strings.HasPrefix(specType, "postgres"):
+		return enginePostgres, nil
+	case strings.HasPrefix(specType, "mysql"), strings.HasPrefix(specType, "mariadb"):
+		return engineMysql, nil
+	}
+	return "", fmt.Errorf("unsupported database type %q", specType)
+}
+
+func buildConnectionString(format string, details connectionDetails) (string, error) {
+	engine, err := databaseEngine(details.Type)
+	if err != nil {
+		return "", err
+	}
+
+	hostPort := net.JoinHostPort(details.Host, strconv.Itoa(details.Port))
+
+	switch format {
+	case "url":
+		dsn := url.URL{
+			Scheme: engine,
+			User:   url.UserPassword(details.User, details.Password),
+			Host:   hostPort,
+		}
+		if details.Database != "" {
+			dsn.Path = "/" + details.Database
+		}
+		return dsn.String(), nil
+	case "jdbc":
+		params := url.Values{}
+		params.Set("user", details.User)
+		params.Set("password", details.Password)
+		return fmt.Sprintf("jdbc:%s://%s/%s?%s", engine, hostPort, url.PathEscape(details.Database), params.Encode()), nil
+	case "libpq":
+		if engine != enginePostgres {
+			return "", fmt.Errorf("the libpq format is only available for PostgreSQL clusters")
+		}
+		parts := []string{
+			"host=" + libpqQuote(details.Host),
+			"port=" + strconv.Itoa(details.Port),
+		}
+		if details.Database != "" {
+			parts = append(parts, "dbname="+libpqQuote(details.Database))
+		}
+		parts = append(parts, "user="+libpqQuote(details.User), "password="+libpqQuote(details.Password))
+		return strings.Join(parts, " "), nil
+	case "env":
+		databaseUrl, err := buildConnectionString("url", details)
+		if err != nil {
+			return "", err
+		}
+
+		prefix := map[string][]string{
+			enginePostgres: {"PGHOST", "PGPORT", "PGDATABASE", "PGUSER", "PGPASSWORD"},
+			engineMysql:    {"MYSQL_HOST", "MYSQL_TCP_PORT", "MYSQL_DATABASE", "MYSQL_USER", "MYSQL_PWD"},
+		}[engine]
+		values := []string{details.Host, strconv.Itoa(details.Port), details.Database, details.User, details.Password}
+
+		lines := []string{"DATABASE_URL=" + shellQuote(databaseUrl)}
+		for i, name := range prefix {
+			if values[i] == "" {
+				continue
+			}
+			lines = append(lines, name+"="+shellQuote(values[i]))
+		}
+		return strings.Join(lines, "\n"), nil
+	}
+
+	return "", fmt.Errorf("unknown format %q, use one of: url, env, jdbc, libpq", format)
+}
+
+// libpqQuote quotes a value for a libpq keyword/value connection string when needed.
+func libpqQuote(value string) string {
+	if value != "" && !strings.ContainsAny(value, ` '\`) {
+		return value
+	}
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `\'`)
+	return "'" + value + "'"
+}
+
+// shellQuote wraps a value in single quotes so it can be sourced by a POSIX shell.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+var connectionStringCloudDatabaseClusterCmd = &cobra.Command{
+	Use:   "connection-string",
+	Short: "Build a connection string for a user of a cloud database cluster",
+	Run: func(cmd *cobra.Command, args []string) {
+		namespace, _ := cmd.Flags().GetString("namespace")
+		clusterName, _ := cmd.Flags().GetString("cluster")
+		userName, _ := cmd.Flags().GetString("user")
+		database, _ := cmd.Flags().GetString("database")
+		format, _ := cmd.Flags().GetString("format")
+		external, _ := cmd.Flags().GetBool("external")
+		container, _ := cmd.Flags().GetString("inject-into-container")
+		envName, _ := cmd.Flags().GetString("env-name")
+
+		resource := api.CloudDatabaseClusterResourceInput{
+			Name:      clusterName,
+			Namespace: namespace,
+		}
+
+		client := api.NewClient()
+		cluster, err := client.CloudDatabaseClusterGet(cmd.Context(), resource)
+		if err != nil {
+			log.Fatalf("Failed to get cloud database cluster: %v", err)
+		}
+
+		user, err := client.CloudDatabaseClusterUserCredentialsGet(cmd.Context(), resource, userName)
+		if err != nil {
+			log.Fatalf("Failed to get user credentials: %v", err)
+		}
+
+		details := connectionDetails{
+			Type:     cluster.Spec.Type,
+			Host:     cluster.Hostname,
+			Port:     cluster.Port,
+			User:     user.Name,
+			Password: user.Password,
+			Database: database,
+		}
+
+		if external {
+			if cluster.ExternalConnection == nil || len(cluster.ExternalConnection.Ports) == 0 {
+				log.Fatalf("External connection is not enabled on cluster %q/%q, enable it with 'nexaa databasecluster external-connection enable'", namespace, clusterName)
+			}
+			details.Host = cluster.ExternalConnection.Ipv4
+			details.Port = cluster.ExternalConnection.Ports[0].ExternalPort
+		}
+
+		if container == "" {
+			connectionString, err := buildConnectionString(format, details)
+			if err != nil {
+				log.Fatalf("Failed to build connection string: %v", err)
+			}
+			fmt.Println(connectionString)
+			return
+		}
+
+		databaseUrl, err := buildConnectionString("url", details)
+		if err != nil {
+			log.Fatalf("Failed to build connection string: %v", err)
+		}
+
+		if _, err := setContainerSecret(cmd.Context(), client, namespace, container, envName, databaseUrl); err != nil {
+			log.Fatalf("Failed to inject %s into container %q/%q: %v", envName, namespace, container, err)
+		}
+		fmt.Printf("Stored %s as secret in container %q/%q.\n", envName, namespace, container)
+	},
+}
+
+func init() {
+	connectionStringCloudDatabaseClusterCmd.Flags().StringP("namespace", "n", "", "Namespace")
+	connectionStringCloudDatabaseClusterCmd.Flags().String("cluster", "", "Name of the cluster")
+	connectionStringCloudDatabaseClusterCmd.Flags().String("user", "", "User name")
+	connectionStringCloudDatabaseClusterCmd.Flags().String("database", "", "Database name")
+	connectionStringCloudDatabaseClusterCmd.Flags().String("format", "url", "Output format (url, env, jdbc or libpq)")
+	connectionStringCloudDatabaseClusterCmd.Flags().Bool("external", false, "Use the external connection instead of the internal hostname")
+	connectionStringCloudDatabaseClusterCmd.Flags().String("inject-into-container", "", "Store the connection URL as a secret in this container")
+	connectionStringCloudDatabaseClusterCmd.Flags().String("env-name", "DATABASE_URL", "Name of the secret used with --inject-into-container")
+	connectionStringCloudDatabaseClusterCmd.MarkFlagRequired("namespace")
+	connectionStringCloudDatabaseClusterCmd.MarkFlagRequired("cluster")
+	connectionStringCloudDatabaseClusterCmd.MarkFlagRequired("user")
+	cloudDatabaseClusterCmd.AddCommand(connectionStringCloudDatabaseClusterCmd)
+}
@@ -39,7 +39,7 @@ var createCloudDatabaseClusterDatabaseCmd = &cobra.Command{
 				State:       api.StatePresent,
 			},
 		}
-		result, err := client.CloudDatabaseClusterDatabaseCreate(input)
+		result, err := client.CloudDatabaseClusterDatabaseCreate(cmd.Context(), input)
 		if err != nil {
 			log.Fatalf("Failed to create database: %v", err)
 			return
@@ -59,17 +59,21 @@ var listCloudDatabaseClusterDatabasesCmd = &cobra.Command{
 			Name:      clusterName,
 			Namespace: namespaceName,
 		}
-		cluster, err := client.CloudDatabaseClusterDatabaseList(input)
+		databases, err := client.CloudDatabaseClusterDatabaseList(cmd.Context(), input)
 		if err != nil {
 			log.Fatalf("Failed to list cloud database clusters: %v", err)
 		}
-		if len(cluster.GetDatabases()) == 0 {
+
+		if printOutput(cmd, databases) {
+			return
+		}
+		if len(databases) == 0 {
 			fmt.Println("No databases found in cloud database cluster.")
 			return
 		}
 		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.Debug)
 		fmt.Fprintln(writer, "DATABASE NAME \tDESCRIPTION \t")
-		for _, database := range cluster.GetDatabases() {
+		for _, database := range databases {
 			if database.Description == nil {
 				database.Description = new(string)
 			}
@@ -95,7 +99,7 @@ var deleteCloudDatabaseClusterDatabaseCmd = &cobra.Command{
 			},
 			Name: name,
 		}
-		_, err := client.CloudDatabaseClusterDatabaseDelete(input)
+		_, err := client.CloudDatabaseClusterDatabaseDelete(cmd.Context(), input)
 		if err != nil {
 			log.Fatalf("Failed to delete cloud database cluster: %v", err)
 		}
@@ -109,6 +113,7 @@ func init() {
 	listCloudDatabaseClusterDatabasesCmd.Flags().String("cluster", "", "Name of the cluster")
 	listCloudDatabaseClusterDatabasesCmd.MarkFlagRequired("namespace")
 	listCloudDatabaseClusterDatabasesCmd.MarkFlagRequired("cluster")
+	addOutputFlag(listCloudDatabaseClusterDatabasesCmd)
 	cloudDatabaseClusterDatabaseCmd.AddCommand(listCloudDatabaseClusterDatabasesCmd)
 
 	deleteCloudDatabaseClusterDatabaseCmd.Flags().StringP("namespace", "n", "", "Namespace")
@@ -35,6 +35,7 @@ var enableCloudDatabaseClusterExternalConnectionCmd = &cobra.Command{
 				Ports: []api.ExternalConnectionPortInput{
 					{
 						AllowList: allowList,
+						Protocol:  api.ProtocolTcp,
 						State:     api.StatePresent,
 					},
 				},
@@ -43,7 +44,7 @@ var enableCloudDatabaseClusterExternalConnectionCmd = &cobra.Command{
 
 		client := api.NewClient()
 
-		cluster, err := client.CloudDatabaseClusterModify(resource)
+		cluster, err := client.CloudDatabaseClusterModify(cmd.Context(), resource)
 		if err != nil {
 			log.Fatalf("Failed to enable external connection in cluster %q/%q: %v", namespace, clusterName, err)
 			return
@@ -72,7 +73,7 @@ var disableCloudDatabaseClusterExternalConnectionCmd = &cobra.Command{
 
 		client := api.NewClient()
 
-		cluster, err := client.CloudDatabaseClusterModify(resource)
+		cluster, err := client.CloudDatabaseClusterModify(cmd.Context(), resource)
 		if err != nil {
 			log.Fatalf("Failed to disable external connection in cluster %q/%q: %v", namespace, clusterName, err)
 			return
@@ -17,6 +17,23 @@ type destructedUser struct {
 	Permission   string
 }
 
+// parseDatabasePermissions parses database:permission pairs into permissions to grant.
+func parseDatabasePermissions(pairs []string) ([]api.DatabaseUserPermissionInput, error) {
+	permissions := make([]api.DatabaseUserPermissionInput, 0, len(pairs))
+	for _, pair := range pairs {
+		database, value, ok := strings.Cut(pair, ":")
+		if !ok || database == "" {
+			return nil, fmt.Errorf("invalid permission format %q, use database:permission, e.g. mydb:READ_WRITE", pair)
+		}
+		permission, err := api.ParseDatabasePermission(value)
+		if err != nil {
+			return nil, fmt.Errorf("database %q: %w", database, err)
+		}
+		permissions = append(permissions, api.DatabaseUserPermissionInput{DatabaseName: database, Permission: permission, State: api.StatePresent})
+	}
+	return permissions, nil
+}
+
 var cloudDatabaseClusterUserCmd = &cobra.Command{
 	Use:     "database_cluster_user",
 	Short:   "Manage user of a cloud database cluster",
@@ -30,7 +47,7 @@ var createCloudDatabaseClusterUserCmd = &cobra.Command{
 		namespace, _ := cmd.Flags().GetString("namespace")
 		clusterName, _ := cmd.Flags().GetString("cluster")
 		userName, _ := cmd.Flags().GetString("user")
-		password, _ := cmd.Flags().GetString("password")
+		password := passwordFromFlags(cmd)
 		permPairs, _ := cmd.Flags().GetStringSlice("permission")
 
 		resource := api.CloudDatabaseClusterResourceInput{
@@ -40,22 +57,9 @@ var createCloudDatabaseClusterUserCmd = &cobra.Command{
 
 		client := api.NewClient()
 
-		permsissions := make([]api.DatabaseUserPermissionInput, 0)
-		for _, p := range permPairs {
-
-			parts := strings.SplitN(p, ":", 2)
-			if len(parts) != 2 {
-				log.Fatalf("Invalid permission format %q. Use database:permission, e.g., mydb:read_write", p)
-				return
-			}
-			name := parts[0]
-			Permission := parts[1]
-
-			permsissions = append(permsissions, api.DatabaseUserPermissionInput{
-				DatabaseName: name,
-				Permission:   api.DatabasePermission(Permission),
-				State:        api.StatePresent,
-			})
+		permissions, err := parseDatabasePermissions(permPairs)
+		if err != nil {
+			log.Fatalf("Invalid --permission: %v", err)
 		}
 
 		input := api.CloudDatabaseClusterUserCreateInput{
@@ -64,11 +68,11 @@ var createCloudDatabaseClusterUserCmd = &cobra.Command{
 				Name:        userName,
 				Password:    &password,
 				State:       api.StatePresent,
-				Permissions: permsissions,
+				Permissions: permissions,
 			},
 		}
 
-		user, err := client.CloudDatabaseClusterUserCreate(input)
+		user, err := client.CloudDatabaseClusterUserCreate(cmd.Context(), input)
 		if err != nil {
 			log.Fatalf("Failed to create user %q in cluster %q/%q: %v", userName, namespace, clusterName, err)
 			return
@@ -84,7 +88,7 @@ var modifyCloudDatabaseClusterUserCmd = &cobra.Command{
 		namespace, _ := cmd.Flags().GetString("namespace")
 		clusterName, _ := cmd.Flags().GetString("cluster")
 		userName, _ := cmd.Flags().GetString("user")
-		newPassword, _ := cmd.Flags().GetString("password")
+		newPassword := passwordFromFlags(cmd)
 		addPerms, _ := cmd.Flags().GetStringSlice("add-permission")
 		removePerms, _ := cmd.Flags().GetStringSlice("remove-permission")
 
@@ -95,21 +99,9 @@ var modifyCloudDatabaseClusterUserCmd = &cobra.Command{
 
 		client := api.NewClient()
 
-		var parsed []api.DatabaseUserPermissionInput
-		for _, p := range addPerms {
-			parts := strings.SplitN(p, ":", 2)
-			if len(parts) != 2 {
-				log.Fatalf("Invalid add-permission format %q. Use database:permission", p)
-				return
-			}
-			name := parts[0]
-			var databasePermission api.DatabasePermission
-			if parts[1] == "read_write" {
-				databasePermission = api.DatabasePermissionReadWrite
-			} else {
-				databasePermission = api.DatabasePermissionReadOnly
-			}
-			parsed = append(parsed, api.DatabaseUserPermissionInput{DatabaseName: name, Permission: databasePermission, State: api.StatePresent})
+		parsed, err := parseDatabasePermissions(addPerms)
+		if err != nil {
+			log.Fatalf("Invalid --add-permission: %v", err)
 		}
 		for _, p := range removePerms {
 			// remove only needs database name; permission is ignored if not provided
@@ -132,7 +124,7 @@ var modifyCloudDatabaseClusterUserCmd = &cobra.Command{
 			User:    &UserInput,
 		}
 
-		user, err := client.CloudDatabaseClusterUserModify(input)
+		user, err := client.CloudDatabaseClusterUserModify(cmd.Context(), input)
 		if err != nil {
 			log.Fatalf("Failed to modify user %q in cluster %q/%q: %v", userName, namespace, clusterName, err)
 			return
@@ -153,10 +145,14 @@ var listCloudDatabaseClusterUserCmd = &cobra.Command{
 		}
 
 		client := api.NewClient()
-		users, err := client.CloudDatabaseClusterUserList(resource)
+		users, err := client.CloudDatabaseClusterUserList(cmd.Context(), resource)
 		if err != nil {
 			log.Fatalf("Failed to list cloud database cluster users: %v", err)
 		}
+
+		if printOutput(cmd, users) {
+			return
+		}
 		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.Debug)
 
 		var destructedUsers []destructedUser
@@ -206,7 +202,7 @@ var deleteCloudDatabaseClusterUserCmd = &cobra.Command{
 		}
 
 		client := api.NewClient()
-		_, err := client.CloudDatabaseClusterUserDelete(api.CloudDatabaseClusterUserResourceInput{
+		_, err := client.CloudDatabaseClusterUserDelete(cmd.Context(), api.CloudDatabaseClusterUserResourceInput{
 			Name:    userName,
 			Cluster: cluster,
 		})
@@ -224,6 +220,7 @@ func init() {
 	listCloudDatabaseClusterUserCmd.Flags().String("cluster", "", "Name of the cluster")
 	listCloudDatabaseClusterUserCmd.MarkFlagRequired("namespace")
 	listCloudDatabaseClusterUserCmd.MarkFlagRequired("cluster")
+	addOutputFlag(listCloudDatabaseClusterUserCmd)
 	cloudDatabaseClusterUserCmd.AddCommand(listCloudDatabaseClusterUserCmd)
 
 	// create
@@ -231,11 +228,12 @@ func init() {
 	createCloudDatabaseClusterUserCmd.Flags().String("cluster", "", "Name of the cluster")
 	createCloudDatabaseClusterUserCmd.Flags().String("user", "", "Username to create")
 	createCloudDatabaseClusterUserCmd.Flags().String("password", "", "Password for the user")
-	createCloudDatabaseClusterUserCmd.Flags().StringSlice("permission", []string{}, "Permissions in the form database:permission (repeatable)")
+	createCloudDatabaseClusterUserCmd.Flags().StringSlice("permission", []string{}, "Permissions in the form database:permission, where permission is READ_ONLY or READ_WRITE (repeatable)")
 	createCloudDatabaseClusterUserCmd.MarkFlagRequired("namespace")
 	createCloudDatabaseClusterUserCmd.MarkFlagRequired("cluster")
 	createCloudDatabaseClusterUserCmd.MarkFlagRequired("user")
-	createCloudDatabaseClusterUserCmd.MarkFlagRequired("password")
+	addPasswordStdinFlag(createCloudDatabaseClusterUserCmd)
+	createCloudDatabaseClusterUserCmd.MarkFlagsOneRequired("password", "password-stdin")
 	cloudDatabaseClusterUserCmd.AddCommand(createCloudDatabaseClusterUserCmd)
 
 	// modify
@@ -243,7 +241,8 @@ func init() {
 	modifyCloudDatabaseClusterUserCmd.Flags().String("cluster", "", "Name of the cluster")
 	modifyCloudDatabaseClusterUserCmd.Flags().String("user", "", "Username to modify")
 	modifyCloudDatabaseClusterUserCmd.Flags().String("password", "", "New password for the user (optional)")
-	modifyCloudDatabaseClusterUserCmd.Flags().StringSlice("add-permission", []string{}, "Add permission in the form database:permission (repeatable)")
+	addPasswordStdinFlag(modifyCloudDatabaseClusterUserCmd)
+	modifyCloudDatabaseClusterUserCmd.Flags().StringSlice("add-permission", []string{}, "Add permission in the form database:permission, where permission is READ_ONLY or READ_WRITE (repeatable)")
 	modifyCloudDatabaseClusterUserCmd.Flags().StringSlice("remove-permission", []string{}, "Remove permission for a database. Accepts database or database:permission (repeatable)")
 	modifyCloudDatabaseClusterUserCmd.MarkFlagRequired("namespace")
 	modifyCloudDatabaseClusterUserCmd.MarkFlagRequired("cluster")
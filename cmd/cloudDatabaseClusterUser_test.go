@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+func TestParseDatabasePermissions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		pairs    []string
+		expected []api.DatabaseUserPermissionInput
+		wantErr  string
+	}{
+		{
+			name:     "no permissions",
+			expected: []api.DatabaseUserPermissionInput{},
+		},
+		{
+			name:  "permissions ignore case",
+			pairs: []string{"app:read_write", "reports:READ_ONLY"},
+			expected: []api.DatabaseUserPermissionInput{
+				{DatabaseName: "app", Permission: api.DatabasePermissionReadWrite, State: api.StatePresent},
+				{DatabaseName: "reports", Permission: api.DatabasePermissionReadOnly, State: api.StatePresent},
+			},
+		},
+		{
+			name:    "unknown permission suggests the closest",
+			pairs:   []string{"app:READ_WRTIE"},
+			wantErr: "did you mean READ_WRITE?",
+		},
+		{
+			name:    "unknown permission is not read only",
+			pairs:   []string{"app:admin"},
+			wantErr: `unknown permission "admin"`,
+		},
+		{
+			name:    "missing permission",
+			pairs:   []string{"app"},
+			wantErr: "use database:permission",
+		},
+		{
+			name:    "missing database",
+			pairs:   []string{":READ_ONLY"},
+			wantErr: "use database:permission",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			permissions, err := parseDatabasePermissions(tt.pairs)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("parseDatabasePermissions(%q) error = %v, want %q", tt.pairs, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseDatabasePermissions(%q) unexpected error: %v", tt.pairs, err)
+			}
+			if !reflect.DeepEqual(permissions, tt.expected) {
+				t.Errorf("parseDatabasePermissions(%q) = %v, want %v", tt.pairs, permissions, tt.expected)
+			}
+		})
+	}
+}
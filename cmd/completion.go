@@ -0,0 +1,399 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+	"github.com/nexaa-cloud/nexaa-cli/config"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// completionCacheTTL is how long fetched names are offered before the API is asked again.
+const completionCacheTTL = 30 * time.Second
+
+type completionCacheEntry struct {
+	Names   []string  `json:"names"`
+	Fetched time.Time `json:"fetched"`
+}
+
+// completionCache keeps the names offered for completion on disk, so pressing tab repeatedly does not call the API every time.
+type completionCache struct {
+	path string
+	ttl  time.Duration
+	now  func() time.Time
+}
+
+func (cache completionCache) read() map[string]completionCacheEntry {
+	entries := map[string]completionCacheEntry{}
+	data, err := os.ReadFile(cache.path)
+	if err != nil {
+		return entries
+	}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return map[string]completionCacheEntry{}
+	}
+	return entries
+}
+
+func (cache completionCache) write(entries map[string]completionCacheEntry) {
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(cache.path), 0700); err != nil {
+		return
+	}
+	_ = os.WriteFile(cache.path, data, 0600)
+}
+
+// names returns the cached names for key, or fetches and caches them when they are missing or expired.
+// Failed fetches are not cached. The cache is best effort, a cache that cannot be written is ignored.
+func (cache completionCache) names(key string, fetch func() ([]string, error)) ([]string, error) {
+	now := cache.now()
+	entries := cache.read()
+	if entry, ok := entries[key]; ok && now.Sub(entry.Fetched) < cache.ttl {
+		return entry.Names, nil
+	}
+
+	names, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	for name, entry := range entries {
+		if now.Sub(entry.Fetched) >= cache.ttl {
+			delete(entries, name)
+		}
+	}
+	entries[key] = completionCacheEntry{Names: names, Fetched: now}
+	cache.write(entries)
+
+	return names, nil
+}
+
+// completion offers the names of one kind of resource. scope lists the flags the names depend on, such as
+// --namespace, their values are passed to fetch in the same order.
+type completion struct {
+	kind  string
+	scope []string
+	fetch func(ctx context.Context, client *api.Client, scope []string) ([]string, error)
+}
+
+func (c completion) complete(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	scope := make([]string, len(c.scope))
+	for i, flag := range c.scope {
+		scope[i], _ = cmd.Flags().GetString(flag)
+		if scope[i] == "" {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+	}
+
+	cache := completionCache{path: config.COMPLETION_CACHE, ttl: completionCacheTTL, now: time.Now}
+	key := strings.Join(append([]string{config.GRAPHQL_URL, c.kind}, scope...), "/")
+	names, err := cache.names(key, func() ([]string, error) {
+		return c.fetch(cmd.Context(), api.NewClient(), scope)
+	})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	return matchingNames(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+func matchingNames(names []string, prefix string) []string {
+	var matches []string
+	for _, name := range names {
+		if strings.HasPrefix(name, prefix) {
+			matches = append(matches, name)
+		}
+	}
+	return matches
+}
+
+// staticCompletion offers a fixed list of values, used for enum flags.
+func staticCompletion(values ...string) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		return matchingNames(values, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+func containerResourcesValues() []string {
+	values := make([]string, len(api.AllContainerResources))
+	for i, resources := range api.AllContainerResources {
+		values[i] = string(resources)
+	}
+	return values
+}
+
+var namespaceCompletion = completion{kind: "namespaces", fetch: func(ctx context.Context, client *api.Client, scope []string) ([]string, error) {
+	namespaces, err := client.NamespacesList(ctx)
+	var names []string
+	for _, namespace := range namespaces {
+		names = append(names, namespace.Name)
+	}
+	return names, err
+}}
+
+var containerCompletion = completion{kind: "containers", scope: []string{"namespace"}, fetch: func(ctx context.Context, client *api.Client, scope []string) ([]string, error) {
+	containers, err := client.ListContainers(ctx, scope[0])
+	var names []string
+	for _, container := range containers {
+		names = append(names, container.Name)
+	}
+	return names, err
+}}
+
+var containerJobCompletion = completion{kind: "jobs", scope: []string{"namespace"}, fetch: func(ctx context.Context, client *api.Client, scope []string) ([]string, error) {
+	jobs, err := client.ContainerJobList(ctx, scope[0])
+	var names []string
+	for _, job := range jobs {
+		names = append(names, job.Name)
+	}
+	return names, err
+}}
+
+var volumeCompletion = completion{kind: "volumes", scope: []string{"namespace"}, fetch: func(ctx context.Context, client *api.Client, scope []string) ([]string, error) {
+	volumes, err := client.ListVolumes(ctx, scope[0])
+	var names []string
+	for _, volume := range volumes {
+		names = append(names, volume.Name)
+	}
+	return names, err
+}}
+
+var registryCompletion = completion{kind: "registries", scope: []string{"namespace"}, fetch: func(ctx context.Context, client *api.Client, scope []string) ([]string, error) {
+	registries, err := client.ListRegistries(ctx, scope[0])
+	var names []string
+	for _, registry := range registries {
+		names = append(names, registry.Name)
+	}
+	return names, err
+}}
+
+var clusterCompletion = completion{kind: "clusters", scope: []string{"namespace"}, fetch: func(ctx context.Context, client *api.Client, scope []string) ([]string, error) {
+	clusters, err := client.CloudDatabaseClusterList(ctx)
+	var names []string
+	for _, cluster := range clusters {
+		if cluster.Namespace.Name == scope[0] {
+			names = append(names, cluster.Name)
+		}
+	}
+	return names, err
+}}
+
+// clusterMembers returns the databases or users of a cluster, scope holds the namespace and cluster name.
+func clusterMembers(users bool) func(ctx context.Context, client *api.Client, scope []string) ([]string, error) {
+	return func(ctx context.Context, client *api.Client, scope []string) ([]string, error) {
+		clusters, err := client.CloudDatabaseClusterList(ctx)
+		var names []string
+		for _, cluster := range clusters {
+			if cluster.Namespace.Name != scope[0] || cluster.Name != scope[1] {
+				continue
+			}
+			if users {
+				for _, user := range cluster.Users {
+					names = append(names, user.Name)
+				}
+			} else {
+				for _, database := range cluster.Databases {
+					names = append(names, database.Name)
+				}
+			}
+		}
+		return names, err
+	}
+}
+
+var databaseCompletion = completion{kind: "databases", scope: []string{"namespace", "cluster"}, fetch: clusterMembers(false)}
+
+var databaseUserCompletion = completion{kind: "database-users", scope: []string{"namespace", "cluster"}, fetch: clusterMembers(true)}
+
+var queueCompletion = completion{kind: "queues", scope: []string{"namespace"}, fetch: func(ctx context.Context, client *api.Client, scope []string) ([]string, error) {
+	queues, err := client.MessageQueueList(ctx)
+	var names []string
+	for _, queue := range queues {
+		if queue.Namespace.Name == scope[0] {
+			names = append(names, queue.Name)
+		}
+	}
+	return names, err
+}}
+
+// queueUserCompletion offers the users of the queue named by the given flag.
+func queueUserCompletion(queueFlag string) completion {
+	return completion{kind: "queue-users", scope: []string{"namespace", queueFlag}, fetch: func(ctx context.Context, client *api.Client, scope []string) ([]string, error) {
+		queues, err := client.MessageQueueList(ctx)
+		var names []string
+		for _, queue := range queues {
+			if queue.Namespace.Name == scope[0] && queue.Name == scope[1] && queue.AdminUser != nil {
+				names = append(names, queue.AdminUser.Name)
+			}
+		}
+		return names, err
+	}}
+}
+
+var queuePlanCompletion = completion{kind: "queue-plans", fetch: func(ctx context.Context, client *api.Client, scope []string) ([]string, error) {
+	plans, err := client.MessageQueuePlans(ctx)
+	var names []string
+	for _, plan := range plans {
+		names = append(names, plan.Name)
+	}
+	return names, err
+}}
+
+var databasePlanCompletion = completion{kind: "database-plans", fetch: func(ctx context.Context, client *api.Client, scope []string) ([]string, error) {
+	plans, err := client.CloudDatabaseClusterListPlans(ctx)
+	var names []string
+	for _, plan := range plans {
+		names = append(names, plan.Name)
+	}
+	return names, err
+}}
+
+// flagCompletions maps command paths, without the root command, to the completions of their flags. The
+// completions of the longest matching path win, the empty path applies to all commands.
+var flagCompletions = map[string]map[string]cobra.CompletionFunc{
+	"": {
+		"namespace":             namespaceCompletion.complete,
+		"containers":            containerCompletion.complete,
+		"inject-into-container": containerCompletion.complete,
+		"resources":             staticCompletion(containerResourcesValues()...),
+		"protocol":              staticCompletion(string(api.ProtocolTcp), string(api.ProtocolUdp)),
+	},
+	"container":                 {"name": containerCompletion.complete},
+	"container_job":             {"name": containerJobCompletion.complete},
+	"volume":                    {"name": volumeCompletion.complete},
+	"registry":                  {"name": registryCompletion.complete},
+	"namespace":                 {"name": namespaceCompletion.complete},
+	"database_cluster_database": {"cluster": clusterCompletion.complete, "name": databaseCompletion.complete},
+	"database_cluster_user":     {"cluster": clusterCompletion.complete, "user": databaseUserCompletion.complete},
+	"databasecluster": {
+		"name":     clusterCompletion.complete,
+		"cluster":  clusterCompletion.complete,
+		"database": databaseCompletion.complete,
+		"user":     databaseUserCompletion.complete,
+		"plan":     databasePlanCompletion.complete,
+	},
+	"queue": {
+		"name":    queueCompletion.complete,
+		"cluster": queueCompletion.complete,
+		"user":    queueUserCompletion("name").complete,
+		"plan":    queuePlanCompletion.complete,
+	},
+	"rotate db-user": {
+		"cluster":  clusterCompletion.complete,
+		"database": databaseCompletion.complete,
+		"user":     databaseUserCompletion.complete,
+	},
+	"rotate queue-user": {
+		"queue": queueCompletion.complete,
+		"user":  queueUserCompletion("queue").complete,
+	},
+}
+
+// argCompletions maps command paths to the completion of their arguments.
+var argCompletions = map[string]cobra.CompletionFunc{
+	"namespace describe": namespaceCompletion.complete,
+	"cost namespace":     namespaceCompletion.complete,
+}
+
+// newNameFlags name the resource a create command makes, there is nothing to complete for them.
+var newNameFlags = []string{"name", "user"}
+
+// flagCompletion returns the completion for a flag of the command at path, or nil when there is none.
+func flagCompletion(path string, command string, flag string) cobra.CompletionFunc {
+	if strings.HasPrefix(command, "create") {
+		for _, name := range newNameFlags {
+			if flag == name {
+				return nil
+			}
+		}
+	}
+
+	for {
+		if complete, ok := flagCompletions[path][flag]; ok {
+			return complete
+		}
+		if path == "" {
+			return nil
+		}
+		if i := strings.LastIndex(path, " "); i >= 0 {
+			path = path[:i]
+		} else {
+			path = ""
+		}
+	}
+}
+
+// registerCompletions adds the completions of flagCompletions and argCompletions to the command and its
+// subcommands. It runs from Execute, when all commands and flags are defined.
+func registerCompletions(command *cobra.Command) {
+	path := strings.TrimPrefix(strings.TrimPrefix(command.CommandPath(), command.Root().Name()), " ")
+
+	command.Flags().VisitAll(func(flag *pflag.Flag) {
+		if complete := flagCompletion(path, command.Name(), flag.Name); complete != nil {
+			_ = command.RegisterFlagCompletionFunc(flag.Name, complete)
+		}
+	})
+	if complete, ok := argCompletions[path]; ok && command.ValidArgsFunction == nil {
+		command.ValidArgsFunction = complete
+	}
+
+	for _, child := range command.Commands() {
+		registerCompletions(child)
+	}
+}
+
+var completionCmd = &cobra.Command{
+	Use:   "completion [bash|zsh|fish|powershell]",
+	Short: "Generate shell completion script",
+	Long: `To enable shell completion, run:
+
+For Bash:
+    source <(nexaa completion bash)
+
+For Zsh:
+    source <(nexaa completion zsh)
+
+For Fish:
+    nexaa completion fish | source
+
+For PowerShell:
+    nexaa completion powershell | Out-String | Invoke-Expression
+
+Or to persist it, save the output to a file and source it in your shell config.
+
+Names of namespaces and resources are completed from the API and cached for a short time.`,
+	ValidArgs: []string{"bash", "zsh", "fish", "powershell"},
+	Args:      cobra.MatchAll(cobra.MaximumNArgs(1), cobra.OnlyValidArgs),
+	Run: func(cmd *cobra.Command, args []string) {
+		shell := "bash"
+		if len(args) > 0 {
+			shell = args[0]
+		}
+
+		var err error
+		switch shell {
+		case "zsh":
+			err = cmd.Root().GenZshCompletion(os.Stdout)
+		case "fish":
+			err = cmd.Root().GenFishCompletion(os.Stdout, true)
+		case "powershell":
+			err = cmd.Root().GenPowerShellCompletionWithDesc(os.Stdout)
+		default:
+			err = cmd.Root().GenBashCompletionV2(os.Stdout, true)
+		}
+		if err != nil {
+			fmt.Printf("Error generating completion: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"errors"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func TestCompletionCache(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 31, 12, 0, 0, 0, time.UTC)
+	cache := completionCache{path: filepath.Join(t.TempDir(), "nexaa", "completion.json"), ttl: 30 * time.Second, now: func() time.Time { return now }}
+
+	fetches := 0
+	fetch := func() ([]string, error) {
+		fetches++
+		return []string{"web", "worker"}, nil
+	}
+
+	for _, step := range []struct {
+		advance time.Duration
+		fetches int
+	}{
+		{advance: 0, fetches: 1},
+		{advance: 10 * time.Second, fetches: 1},
+		{advance: 30 * time.Second, fetches: 2},
+	} {
+		now = now.Add(step.advance)
+		names, err := cache.names("containers/production", fetch)
+		if err != nil {
+			t.Fatalf("names() unexpected error: %v", err)
+		}
+		if !slices.Equal(names, []string{"web", "worker"}) {
+			t.Errorf("names() = %v, expected [web worker]", names)
+		}
+		if fetches != step.fetches {
+			t.Errorf("after %s fetched %d times, expected %d", step.advance, fetches, step.fetches)
+		}
+	}
+}
+
+func TestCompletionCacheDoesNotCacheErrors(t *testing.T) {
+	t.Parallel()
+
+	cache := completionCache{path: filepath.Join(t.TempDir(), "completion.json"), ttl: time.Minute, now: time.Now}
+
+	if _, err := cache.names("namespaces", func() ([]string, error) { return nil, errors.New("unauthorized") }); err == nil {
+		t.Fatalf("names() expected the fetch error")
+	}
+
+	names, err := cache.names("namespaces", func() ([]string, error) { return []string{"production"}, nil })
+	if err != nil || !slices.Equal(names, []string{"production"}) {
+		t.Errorf("names() = %v, %v, expected [production]", names, err)
+	}
+}
+
+func TestMatchingNames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		prefix   string
+		expected []string
+	}{
+		{prefix: "", expected: []string{"web", "worker", "db"}},
+		{prefix: "w", expected: []string{"web", "worker"}},
+		{prefix: "wo", expected: []string{"worker"}},
+		{prefix: "x", expected: nil},
+	}
+
+	for _, test := range tests {
+		if got := matchingNames([]string{"web", "worker", "db"}, test.prefix); !slices.Equal(got, test.expected) {
+			t.Errorf("matchingNames(%q) = %v, expected %v", test.prefix, got, test.expected)
+		}
+	}
+}
+
+func TestFlagCompletion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		path     string
+		command  string
+		flag     string
+		expected bool
+	}{
+		{path: "container delete", command: "delete", flag: "name", expected: true},
+		{path: "container create", command: "create", flag: "name", expected: false},
+		{path: "container create", command: "create", flag: "namespace", expected: true},
+		{path: "container create-starter", command: "create-starter", flag: "name", expected: false},
+		{path: "queue external-connection enable", command: "enable", flag: "cluster", expected: true},
+		{path: "rotate queue-user", command: "queue-user", flag: "user", expected: true},
+		{path: "login", command: "login", flag: "username", expected: false},
+		{path: "cost estimate", command: "estimate", flag: "resources", expected: true},
+	}
+
+	for _, test := range tests {
+		if got := flagCompletion(test.path, test.command, test.flag) != nil; got != test.expected {
+			t.Errorf("flagCompletion(%q, %q) found = %v, expected %v", test.path, test.flag, got, test.expected)
+		}
+	}
+}
+
+func TestStaticCompletion(t *testing.T) {
+	t.Parallel()
+
+	complete := flagCompletion("container external-connection enable", "enable", "protocol")
+	values, directive := complete(&cobra.Command{}, nil, "U")
+	if !slices.Equal(values, []string{"UDP"}) || directive != cobra.ShellCompDirectiveNoFileComp {
+		t.Errorf("protocol completion = %v, %v, expected [UDP] without files", values, directive)
+	}
+}
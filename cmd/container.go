@@ -23,11 +23,15 @@ var getContainerCmd = &cobra.Command{
 		name, _ := cmd.Flags().GetString("name")
 		client := api.NewClient()
 
-		container, err := client.ListContainerByName(namespace, name)
+		container, err := client.ListContainerByName(cmd.Context(), namespace, name)
 		if err != nil {
 			log.Fatalf("Failed to get container : %v", err)
 		}
 
+		if printOutput(cmd, container) {
+			return
+		}
+
 		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.Debug)
 
 		fmt.Fprintln(writer, "NAME\t IMAGE\t RESOURCES\t")
@@ -45,12 +49,16 @@ var listContainersCmd = &cobra.Command{
 		namespace, _ := cmd.Flags().GetString("namespace")
 		client := api.NewClient()
 
-		containers, err := client.ListContainers(namespace)
+		containers, err := client.ListContainers(cmd.Context(), namespace)
 
 		if err != nil {
 			log.Fatalf("Failed to list containers: %v", err)
 		}
 
+		if printOutput(cmd, containers) {
+			return
+		}
+
 		if len(containers) == 0 {
 			fmt.Println("No containers found.")
 			return
@@ -75,9 +83,8 @@ var createContainerCmd = &cobra.Command{
 		namespace, _ := cmd.Flags().GetString("namespace")
 		name, _ := cmd.Flags().GetString("name")
 		image, _ := cmd.Flags().GetString("image")
-		resources, _ := cmd.Flags().GetString("resources")
-		environmentVariables, _ := cmd.Flags().GetStringArray("env")
-		secrets, _ := cmd.Flags().GetStringArray("secret")
+		resources := resourcesFromFlags(cmd)
+		environmentVariables, secrets := environmentFromFlags(cmd)
 		command, _ := cmd.Flags().GetStringArray("command")
 		entrypoint, _ := cmd.Flags().GetStringArray("entrypoint")
 
@@ -99,7 +106,7 @@ var createContainerCmd = &cobra.Command{
 
 		client := api.NewClient()
 
-		container, err := client.ContainerCreate(input)
+		container, err := client.ContainerCreate(cmd.Context(), input)
 
 		if err != nil {
 			log.Fatalf("Failed to create container: %v", err)
@@ -117,8 +124,7 @@ var createStarterContainerCmd = &cobra.Command{
 		namespace, _ := cmd.Flags().GetString("namespace")
 		name, _ := cmd.Flags().GetString("name")
 		image, _ := cmd.Flags().GetString("image")
-		environmentVariables, _ := cmd.Flags().GetStringArray("env")
-		secrets, _ := cmd.Flags().GetStringArray("secret")
+		environmentVariables, secrets := environmentFromFlags(cmd)
 		command, _ := cmd.Flags().GetStringArray("command")
 		entrypoint, _ := cmd.Flags().GetStringArray("entrypoint")
 
@@ -140,7 +146,7 @@ var createStarterContainerCmd = &cobra.Command{
 
 		client := api.NewClient()
 
-		container, err := client.ContainerCreate(input)
+		container, err := client.ContainerCreate(cmd.Context(), input)
 
 		if err != nil {
 			log.Fatalf("Failed to create starter container: %v", err)
@@ -158,9 +164,8 @@ var modifyContainerCmd = &cobra.Command{
 		namespace, _ := cmd.Flags().GetString("namespace")
 		name, _ := cmd.Flags().GetString("name")
 		image, _ := cmd.Flags().GetString("image")
-		resources, _ := cmd.Flags().GetString("resources")
-		environmentVariables, _ := cmd.Flags().GetStringArray("env")
-		secrets, _ := cmd.Flags().GetStringArray("secret")
+		resources := resourcesFromFlags(cmd)
+		environmentVariables, secrets := environmentFromFlags(cmd)
 		removedEnvironmentVariables, _ := cmd.Flags().GetStringArray("remove-env")
 		registry, _ := cmd.Flags().GetString("registry")
 		removeRegistry, _ := cmd.Flags().GetBool("remove-registry")
@@ -169,19 +174,12 @@ var modifyContainerCmd = &cobra.Command{
 
 		client := api.NewClient()
 
-		oldContainer, err := client.ListContainerByName(namespace, name)
+		oldContainer, err := client.ListContainerByName(cmd.Context(), namespace, name)
 		if err != nil {
 			log.Fatalf("Container not found: %v", err)
 		}
 
-		envs := append(
-			envsToApi(environmentVariables, false, api.StatePresent),
-			envsToApi(secrets, true, api.StatePresent)...,
-		)
-		envs = append(
-			envs,
-			envsToApi(removedEnvironmentVariables, false, api.StateAbsent)...,
-		)
+		envs := diffEnvironment(oldContainer.EnvironmentVariables, environmentVariables, secrets, removedEnvironmentVariables)
 
 		input := api.ContainerModifyInput{
 			Name:                 name,
@@ -217,7 +215,7 @@ var modifyContainerCmd = &cobra.Command{
 			input.Entrypoint = entrypoint
 		}
 
-		container, err := client.ContainerModify(input)
+		container, err := client.ContainerModify(cmd.Context(), input)
 		if err != nil {
 			log.Fatalf("Failed to modify container: %v", err)
 			return
@@ -236,7 +234,9 @@ var deleteContainerCmd = &cobra.Command{
 
 		client := api.NewClient()
 
-		result, err := client.ContainerDelete(namespace, name)
+		confirmDeletion(cmd, "container", name)
+
+		result, err := client.ContainerDelete(cmd.Context(), namespace, name)
 		if err != nil {
 			log.Fatalf("Failed to delete container: %v", err)
 			return
@@ -254,9 +254,10 @@ func init() {
 	createContainerCmd.Flags().StringP("namespace", "n", "", "Namespace")
 	createContainerCmd.Flags().String("name", "", "Name for the container")
 	createContainerCmd.Flags().String("image", "", "Container image")
-	createContainerCmd.Flags().String("resources", "", "Container resources")
+	addResourcesFlags(createContainerCmd, "Container resources")
 	createContainerCmd.Flags().StringArray("env", []string{}, "Container environment variables")
-	createContainerCmd.Flags().StringArray("secret", []string{}, "Container secrets")
+	createContainerCmd.Flags().StringArray("secret", []string{}, "Container secrets (NAME=VALUE, NAME=@path to read it from a file or NAME=- to read it from stdin)")
+	addEnvFileFlags(createContainerCmd)
 	createContainerCmd.MarkFlagRequired("namespace")
 	createContainerCmd.MarkFlagRequired("name")
 	createContainerCmd.MarkFlagRequired("image")
@@ -268,7 +269,8 @@ func init() {
 	createStarterContainerCmd.Flags().String("name", "", "Name for the container")
 	createStarterContainerCmd.Flags().String("image", "", "Container image")
 	createStarterContainerCmd.Flags().StringArray("env", []string{}, "Container environment variables")
-	createStarterContainerCmd.Flags().StringArray("secret", []string{}, "Container secrets")
+	createStarterContainerCmd.Flags().StringArray("secret", []string{}, "Container secrets (NAME=VALUE, NAME=@path to read it from a file or NAME=- to read it from stdin)")
+	addEnvFileFlags(createStarterContainerCmd)
 	createStarterContainerCmd.Flags().StringArray("entrypoint", []string{}, "Entrypoint for the container")
 	createStarterContainerCmd.Flags().StringArray("command", []string{}, "Command to run in the container")
 	createStarterContainerCmd.MarkFlagRequired("namespace")
@@ -279,11 +281,12 @@ func init() {
 	modifyContainerCmd.Flags().StringP("namespace", "n", "", "Namespace")
 	modifyContainerCmd.Flags().String("name", "", "Name for the container")
 	modifyContainerCmd.Flags().String("image", "", "Container image")
-	modifyContainerCmd.Flags().String("resources", "", "Container resources")
+	addResourcesFlags(modifyContainerCmd, "Container resources")
 	modifyContainerCmd.Flags().StringArray("command", []string{}, "Command to run in the container")
 	modifyContainerCmd.Flags().StringArray("entrypoint", []string{}, "Entrypoint for the container")
 	modifyContainerCmd.Flags().StringArray("env", []string{}, "Container environment variables")
-	modifyContainerCmd.Flags().StringArray("secret", []string{}, "Container secrets")
+	modifyContainerCmd.Flags().StringArray("secret", []string{}, "Container secrets (NAME=VALUE, NAME=@path to read it from a file or NAME=- to read it from stdin)")
+	addEnvFileFlags(modifyContainerCmd)
 	modifyContainerCmd.Flags().StringArray("remove-env", []string{}, "Container remove environment variables")
 	modifyContainerCmd.Flags().String("registry", "", "Registry name for container image")
 	modifyContainerCmd.Flags().Bool("remove-registry", false, "Registry name for container image")
@@ -293,10 +296,12 @@ func init() {
 
 	listContainersCmd.Flags().StringP("namespace", "n", "", "Namespace")
 	listContainersCmd.MarkFlagRequired("namespace")
+	addOutputFlag(listContainersCmd)
 	containerCmd.AddCommand(listContainersCmd)
 
 	deleteContainerCmd.Flags().StringP("namespace", "n", "", "Namespace")
 	deleteContainerCmd.Flags().String("name", "", "Name of this container")
+	addConfirmFlag(deleteContainerCmd)
 	deleteContainerCmd.MarkFlagRequired("namespace")
 	deleteContainerCmd.MarkFlagRequired("name")
 	containerCmd.AddCommand(deleteContainerCmd)
@@ -305,6 +310,7 @@ func init() {
 	getContainerCmd.Flags().String("name", "", "Name of the container")
 	getContainerCmd.MarkFlagRequired("namespace")
 	getContainerCmd.MarkFlagRequired("Name")
+	addOutputFlag(getContainerCmd)
 	containerCmd.AddCommand(getContainerCmd)
 
 	containerCmd.AddCommand(containerEnableExternalConnectionCmd)
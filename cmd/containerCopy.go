@@ -0,0 +1,512 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// namespaceResources holds the names of the volumes and registries in the target namespace of a copy.
+type namespaceResources struct {
+	volumes    map[string]bool
+	registries map[string]bool
+}
+
+// parseResourceRef splits a "namespace/name" reference.
+func parseResourceRef(ref string) (string, string, error) {
+	namespace, name, ok := strings.Cut(ref, "/")
+	if !ok || namespace == "" || name == "" || strings.Contains(name, "/") {
+		return "", "", fmt.Errorf("invalid reference %q, use namespace/name", ref)
+	}
+	return namespace, name, nil
+}
+
+// parseDomainOverrides parses PORT=DOMAIN pairs.
+func parseDomainOverrides(domains []string) (map[int]string, error) {
+	overrides := map[int]string{}
+	for _, domain := range domains {
+		port, name, ok := strings.Cut(domain, "=")
+		number, err := strconv.Atoi(port)
+		if !ok || err != nil || name == "" {
+			return nil, fmt.Errorf("invalid domain %q, use PORT=DOMAIN", domain)
+		}
+		overrides[number] = name
+	}
+	return overrides, nil
+}
+
+func loadNamespaceResources(ctx context.Context, client *api.Client, namespace string) namespaceResources {
+	resources := namespaceResources{volumes: map[string]bool{}, registries: map[string]bool{}}
+
+	volumes, err := client.ListVolumes(ctx, namespace)
+	if err != nil {
+		log.Fatalf("Failed to list volumes in namespace %q: %v", namespace, err)
+	}
+	for _, volume := range volumes {
+		resources.volumes[volume.Name] = true
+	}
+
+	registries, err := client.ListRegistries(ctx, namespace)
+	if err != nil {
+		log.Fatalf("Failed to list registries in namespace %q: %v", namespace, err)
+	}
+	for _, registry := range registries {
+		resources.registries[registry.Name] = true
+	}
+
+	return resources
+}
+
+// remapRegistry keeps the registry when one with the same name exists in the target namespace.
+func remapRegistry(registry string, target namespaceResources) (*string, []string) {
+	if registry == "" || registry == "public" {
+		return nil, nil
+	}
+	if !target.registries[registry] {
+		return nil, []string{fmt.Sprintf("registry %q does not exist in the target namespace, the image is pulled without it", registry)}
+	}
+	return &registry, nil
+}
+
+// remapMounts keeps the mounts of volumes with the same name in the target namespace.
+func remapMounts(mounts []api.ContainerMounts, target namespaceResources) ([]api.MountInput, []string) {
+	var inputs []api.MountInput
+	var skipped []string
+	for _, mount := range mounts {
+		if !target.volumes[mount.Volume.Name] {
+			skipped = append(skipped, fmt.Sprintf("mount %s: volume %q does not exist in the target namespace", mount.Path, mount.Volume.Name))
+			continue
+		}
+		inputs = append(inputs, api.MountInput{
+			Path:   mount.Path,
+			Volume: api.MountVolumeInput{Name: mount.Volume.Name},
+			State:  api.StatePresent,
+		})
+	}
+	return inputs, skipped
+}
+
+// copyIngresses copies the ingresses without their domain names, unless a domain is given for the port.
+// Ports that already have an ingress in the target are left alone.
+func copyIngresses(ingresses []api.ContainerResultIngressesIngress, domains map[int]string, existing []api.ContainerResultIngressesIngress) ([]api.IngressInput, []string) {
+	existingPorts := map[int]bool{}
+	for _, ingress := range existing {
+		existingPorts[ingress.Port] = true
+	}
+
+	inputs := []api.IngressInput{}
+	var skipped []string
+	for _, ingress := range ingresses {
+		if existingPorts[ingress.Port] {
+			skipped = append(skipped, fmt.Sprintf("ingress on port %d: the target already has an ingress on this port", ingress.Port))
+			continue
+		}
+
+		input := api.IngressInput{
+			Port:      ingress.Port,
+			EnableTLS: ingress.EnableTLS,
+			Whitelist: ingress.Allowlist,
+			State:     api.StatePresent,
+		}
+		if domain, ok := domains[ingress.Port]; ok {
+			input.DomainName = &domain
+		} else {
+			skipped = append(skipped, fmt.Sprintf("domain %s of the ingress on port %d: a domain is generated instead", ingress.DomainName, ingress.Port))
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs, skipped
+}
+
+func scalingFromContainer(container api.ContainerResult) *api.ScalingInput {
+	if container.AutoScaling != nil {
+		triggers := make([]api.AutoScalingTriggerInput, len(container.AutoScaling.Triggers))
+		for i, trigger := range container.AutoScaling.Triggers {
+			triggers[i] = api.AutoScalingTriggerInput{
+				Type:      api.AutoScalingType(trigger.Type),
+				Threshold: trigger.Threshold,
+			}
+		}
+		return &api.ScalingInput{Auto: &api.AutoScalingInput{
+			Replicas: api.ReplicasInput{
+				Minimum: container.AutoScaling.Replicas.Minimum,
+				Maximum: container.AutoScaling.Replicas.Maximum,
+			},
+			Triggers: triggers,
+		}}
+	}
+
+	if container.NumberOfReplicas > 0 {
+		return &api.ScalingInput{Manual: &api.ManualScalingInput{Replicas: container.NumberOfReplicas}}
+	}
+	return nil
+}
+
+// copyEnvironment returns the plain variables of the source with the overrides applied and the names
+// of the secrets of which the API does not return a value and no override is given.
+func copyEnvironment(source []api.EnvironmentVariableResult, envs []string, secrets []string) ([]string, []string) {
+	var plain, missing []string
+	given := map[string]bool{}
+	for _, secret := range secrets {
+		name, _, _ := strings.Cut(secret, "=")
+		given[name] = true
+	}
+
+	for _, env := range source {
+		switch {
+		case env.Secret && !given[env.Name]:
+			missing = append(missing, env.Name)
+		case !env.Secret && env.Value != nil:
+			plain = append(plain, env.Name+"="+*env.Value)
+		}
+	}
+
+	return mergeEnvironment(plain, envs), missing
+}
+
+// promptSecrets asks for the values of the missing secrets, they are skipped without a terminal.
+func promptSecrets(missing []string) ([]string, []string) {
+	var secrets, skipped []string
+	interactive := term.IsTerminal(int(os.Stdin.Fd()))
+
+	for _, name := range missing {
+		if !interactive {
+			skipped = append(skipped, fmt.Sprintf("secret %s: no value given, pass it with --secret or --secret-file", name))
+			continue
+		}
+
+		fmt.Fprintf(os.Stderr, "Value for secret %s (leave empty to skip): ", name)
+		value, err := term.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			log.Fatalf("Failed to read secret %s: %v", name, err)
+		}
+
+		if len(value) == 0 {
+			skipped = append(skipped, fmt.Sprintf("secret %s: no value given", name))
+			continue
+		}
+		secrets = append(secrets, name+"="+string(value))
+	}
+
+	return secrets, skipped
+}
+
+func printSkipped(skipped []string) {
+	if len(skipped) == 0 {
+		return
+	}
+
+	fmt.Println("Skipped:")
+	for _, line := range skipped {
+		fmt.Printf("  %s\n", line)
+	}
+}
+
+// copyRefsFromFlags returns the source and target namespace and name of a copy.
+func copyRefsFromFlags(cmd *cobra.Command) (string, string, string, string) {
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
+
+	fromNamespace, fromName, err := parseResourceRef(from)
+	if err != nil {
+		log.Fatalf("Invalid --from: %v", err)
+	}
+	toNamespace, toName, err := parseResourceRef(to)
+	if err != nil {
+		log.Fatalf("Invalid --to: %v", err)
+	}
+	if fromNamespace == toNamespace && fromName == toName {
+		log.Fatalf("--from and --to are the same")
+	}
+
+	return fromNamespace, fromName, toNamespace, toName
+}
+
+// copyOverridesFromFlags applies the --image, --resources and --registry overrides.
+func copyOverridesFromFlags(cmd *cobra.Command, image string, resources api.ContainerResources, registry *string) (string, api.ContainerResources, *string) {
+	if override, _ := cmd.Flags().GetString("image"); override != "" {
+		image = override
+	}
+	if override := resourcesFromFlags(cmd); override != "" {
+		resources = api.ContainerResources(override)
+	}
+	if cmd.Flags().Changed("registry") {
+		override, _ := cmd.Flags().GetString("registry")
+		registry = nil
+		if override != "" {
+			registry = &override
+		}
+	}
+	return image, resources, registry
+}
+
+// checkRegistryOnModify returns an error when the copy pulls a public image but the existing target uses a
+// private registry, modify leaves the registry unchanged when none is given so the target would keep it.
+func checkRegistryOnModify(current string, registry *string) error {
+	if registry == nil && current != "" && current != "public" {
+		return fmt.Errorf("the target uses private registry %q and modifying it cannot switch to a public image, remove the registry from the target first or pass --registry", current)
+	}
+	return nil
+}
+
+var copyContainerCmd = &cobra.Command{
+	Use:   "copy",
+	Short: "Copy a container to another namespace or name",
+	Long: `Copy a container to another namespace or name.
+
+The image, resources, command, environment, mounts, ports, ingresses, health check and scaling
+are copied. Volumes and registries are matched by name in the target namespace and ingress
+domains are not copied unless given with --domain. The API does not return secret values, they
+are taken from --secret or --secret-file or asked for. When the target container already
+exists it is modified instead.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		fromNamespace, fromName, toNamespace, toName := copyRefsFromFlags(cmd)
+		domainFlags, _ := cmd.Flags().GetStringArray("domain")
+
+		domains, err := parseDomainOverrides(domainFlags)
+		if err != nil {
+			log.Fatalf("Invalid --domain: %v", err)
+		}
+
+		client := api.NewClient()
+
+		source, err := client.ListContainerByName(cmd.Context(), fromNamespace, fromName)
+		if err != nil {
+			log.Fatalf("Failed to get container %s/%s: %v", fromNamespace, fromName, err)
+		}
+
+		targets, err := client.ListContainers(cmd.Context(), toNamespace)
+		if err != nil {
+			log.Fatalf("Failed to list containers in namespace %q: %v", toNamespace, err)
+		}
+		var existing *api.ContainerResult
+		for _, container := range targets {
+			if container.Name == toName {
+				existing = &container
+				break
+			}
+		}
+
+		target := loadNamespaceResources(cmd.Context(), client, toNamespace)
+
+		var skipped []string
+		sourceRegistry := ""
+		if source.PrivateRegistry != nil {
+			sourceRegistry = source.PrivateRegistry.Name
+		}
+		registry, registrySkipped := remapRegistry(sourceRegistry, target)
+		skipped = append(skipped, registrySkipped...)
+
+		mounts, mountsSkipped := remapMounts(source.Mounts, target)
+		skipped = append(skipped, mountsSkipped...)
+
+		var existingIngresses []api.ContainerResultIngressesIngress
+		if existing != nil {
+			existingIngresses = existing.Ingresses
+		}
+		ingresses, ingressesSkipped := copyIngresses(source.Ingresses, domains, existingIngresses)
+		skipped = append(skipped, ingressesSkipped...)
+
+		envFlags, secrets := environmentFromFlags(cmd)
+		envs, missing := copyEnvironment(source.EnvironmentVariables, envFlags, secrets)
+		prompted, secretsSkipped := promptSecrets(missing)
+		secrets = append(secrets, prompted...)
+		skipped = append(skipped, secretsSkipped...)
+
+		image, resources, registry := copyOverridesFromFlags(cmd, source.Image, source.Resources, registry)
+
+		var healthCheck *api.HealthCheckInput
+		if source.HealthCheck != nil {
+			healthCheck = &api.HealthCheckInput{Port: source.HealthCheck.Port, Path: source.HealthCheck.Path}
+		}
+
+		if existing == nil {
+			container, err := client.ContainerCreate(cmd.Context(), api.ContainerCreateInput{
+				Name:                 toName,
+				Namespace:            toNamespace,
+				Resources:            resources,
+				Registry:             registry,
+				Image:                image,
+				EnvironmentVariables: append(envsToApi(envs, false, api.StatePresent), envsToApi(secrets, true, api.StatePresent)...),
+				Mounts:               append([]api.MountInput{}, mounts...),
+				Ports:                append([]string{}, source.Ports...),
+				Ingresses:            ingresses,
+				Scaling:              scalingFromContainer(source),
+				HealthCheck:          healthCheck,
+				Type:                 source.Type,
+				Command:              source.Command,
+				Entrypoint:           source.Entrypoint,
+			})
+			if err != nil {
+				log.Fatalf("Failed to create container %s/%s: %v", toNamespace, toName, err)
+			}
+			fmt.Printf("Created container %s/%s from %s/%s.\n", toNamespace, container.Name, fromNamespace, fromName)
+			printSkipped(skipped)
+			return
+		}
+
+		if existing.PrivateRegistry != nil {
+			if err := checkRegistryOnModify(existing.PrivateRegistry.Name, registry); err != nil {
+				log.Fatalf("Failed to modify container %s/%s: %v", toNamespace, toName, err)
+			}
+		}
+
+		container, err := client.ContainerModify(cmd.Context(), api.ContainerModifyInput{
+			Name:                 toName,
+			Namespace:            toNamespace,
+			Resources:            &resources,
+			Registry:             registry,
+			Image:                &image,
+			EnvironmentVariables: diffEnvironment(existing.EnvironmentVariables, envs, secrets, nil),
+			Mounts:               mounts,
+			Ports:                source.Ports,
+			Ingresses:            ingresses,
+			Scaling:              scalingFromContainer(source),
+			HealthCheck:          healthCheck,
+			Command:              source.Command,
+			Entrypoint:           source.Entrypoint,
+		})
+		if err != nil {
+			log.Fatalf("Failed to modify container %s/%s: %v", toNamespace, toName, err)
+		}
+		fmt.Printf("Updated container %s/%s from %s/%s.\n", toNamespace, container.Name, fromNamespace, fromName)
+		printSkipped(skipped)
+	},
+}
+
+var copyContainerJobCmd = &cobra.Command{
+	Use:   "copy",
+	Short: "Copy a container job to another namespace or name",
+	Long: `Copy a container job to another namespace or name.
+
+The image, resources, command, schedule, environment and mounts are copied. Volumes and
+registries are matched by name in the target namespace. The API does not return secret
+values, they are taken from --secret or --secret-file or asked for. When the target job
+already exists it is modified instead.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		fromNamespace, fromName, toNamespace, toName := copyRefsFromFlags(cmd)
+
+		client := api.NewClient()
+
+		source, err := client.ContainerJobByName(cmd.Context(), fromNamespace, fromName)
+		if err != nil {
+			log.Fatalf("Failed to get container job %s/%s: %v", fromNamespace, fromName, err)
+		}
+
+		targets, err := client.ContainerJobList(cmd.Context(), toNamespace)
+		if err != nil {
+			log.Fatalf("Failed to list container jobs in namespace %q: %v", toNamespace, err)
+		}
+		var existing *api.ContainerJobResult
+		for _, job := range targets {
+			if job.Name == toName {
+				existing = &job
+				break
+			}
+		}
+
+		target := loadNamespaceResources(cmd.Context(), client, toNamespace)
+
+		var skipped []string
+		sourceRegistry := ""
+		if source.PrivateRegistry != nil {
+			sourceRegistry = source.PrivateRegistry.Name
+		}
+		registry, registrySkipped := remapRegistry(sourceRegistry, target)
+		skipped = append(skipped, registrySkipped...)
+
+		mounts, mountsSkipped := remapMounts(source.Mounts, target)
+		skipped = append(skipped, mountsSkipped...)
+
+		envFlags, secrets := environmentFromFlags(cmd)
+		envs, missing := copyEnvironment(source.EnvironmentVariables, envFlags, secrets)
+		prompted, secretsSkipped := promptSecrets(missing)
+		secrets = append(secrets, prompted...)
+		skipped = append(skipped, secretsSkipped...)
+
+		image, resources, registry := copyOverridesFromFlags(cmd, source.Image, source.Resources, registry)
+
+		schedule := source.Schedule
+		if override, _ := cmd.Flags().GetString("schedule"); override != "" {
+			schedule = override
+		}
+
+		if existing == nil {
+			job, err := client.ContainerJobCreate(cmd.Context(), api.ContainerJobCreateInput{
+				Name:                 toName,
+				Namespace:            toNamespace,
+				Resources:            resources,
+				Registry:             registry,
+				Image:                image,
+				EnvironmentVariables: append(envsToApi(envs, false, api.StatePresent), envsToApi(secrets, true, api.StatePresent)...),
+				Mounts:               append([]api.MountInput{}, mounts...),
+				Schedule:             schedule,
+				Enabled:              source.Enabled,
+				Command:              source.Command,
+				Entrypoint:           source.Entrypoint,
+			})
+			if err != nil {
+				log.Fatalf("Failed to create container job %s/%s: %v", toNamespace, toName, err)
+			}
+			fmt.Printf("Created container job %s/%s from %s/%s.\n", toNamespace, job.Name, fromNamespace, fromName)
+			printSkipped(skipped)
+			return
+		}
+
+		if existing.PrivateRegistry != nil {
+			if err := checkRegistryOnModify(existing.PrivateRegistry.Name, registry); err != nil {
+				log.Fatalf("Failed to modify container job %s/%s: %v", toNamespace, toName, err)
+			}
+		}
+
+		job, err := client.ContainerJobModify(cmd.Context(), api.ContainerJobModifyInput{
+			Name:                 toName,
+			Namespace:            toNamespace,
+			Resources:            &resources,
+			Registry:             registry,
+			Image:                &image,
+			Schedule:             &schedule,
+			Enabled:              &source.Enabled,
+			EnvironmentVariables: diffEnvironment(existing.EnvironmentVariables, envs, secrets, nil),
+			Mounts:               mounts,
+			Command:              source.Command,
+			Entrypoint:           source.Entrypoint,
+		})
+		if err != nil {
+			log.Fatalf("Failed to modify container job %s/%s: %v", toNamespace, toName, err)
+		}
+		fmt.Printf("Updated container job %s/%s from %s/%s.\n", toNamespace, job.Name, fromNamespace, fromName)
+		printSkipped(skipped)
+	},
+}
+
+func addCopyFlags(cmd *cobra.Command) {
+	cmd.Flags().String("from", "", "Source as namespace/name")
+	cmd.Flags().String("to", "", "Target as namespace/name")
+	cmd.Flags().String("image", "", "Override the image")
+	addResourcesFlags(cmd, "Override the resources")
+	cmd.Flags().String("registry", "", "Override the private registry, pass an empty value for a public image (only when the target does not use a private registry yet)")
+	cmd.Flags().StringArray("env", []string{}, "Set or override an environment variable (NAME=VALUE)")
+	cmd.Flags().StringArray("secret", []string{}, "Set a secret (NAME=VALUE, NAME=@path to read it from a file or NAME=- to read it from stdin)")
+	addEnvFileFlags(cmd)
+	cmd.MarkFlagRequired("from")
+	cmd.MarkFlagRequired("to")
+}
+
+func init() {
+	addCopyFlags(copyContainerCmd)
+	copyContainerCmd.Flags().StringArray("domain", []string{}, "Domain for the ingress on a port (PORT=DOMAIN)")
+	containerCmd.AddCommand(copyContainerCmd)
+
+	addCopyFlags(copyContainerJobCmd)
+	copyContainerJobCmd.Flags().String("schedule", "", "Override the schedule")
+	containerJobCmd.AddCommand(copyContainerJobCmd)
+}
@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+func TestParseResourceRef(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ref       string
+		namespace string
+		name      string
+		wantErr   bool
+	}{
+		{ref: "staging/api", namespace: "staging", name: "api"},
+		{ref: "api", wantErr: true},
+		{ref: "/api", wantErr: true},
+		{ref: "staging/", wantErr: true},
+		{ref: "a/b/c", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			t.Parallel()
+
+			namespace, name, err := parseResourceRef(tt.ref)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseResourceRef(%q) error = %v, wantErr %v", tt.ref, err, tt.wantErr)
+			}
+			if namespace != tt.namespace || name != tt.name {
+				t.Errorf("parseResourceRef(%q) = %q, %q, expected %q, %q", tt.ref, namespace, name, tt.namespace, tt.name)
+			}
+		})
+	}
+}
+
+func TestParseDomainOverrides(t *testing.T) {
+	t.Parallel()
+
+	domains, err := parseDomainOverrides([]string{"80=api.example.com", "8080=admin.example.com"})
+	if err != nil {
+		t.Fatalf("parseDomainOverrides() error = %v", err)
+	}
+	if domains[80] != "api.example.com" || domains[8080] != "admin.example.com" {
+		t.Errorf("parseDomainOverrides() = %v", domains)
+	}
+
+	for _, invalid := range []string{"api.example.com", "http=api.example.com", "80="} {
+		if _, err := parseDomainOverrides([]string{invalid}); err == nil {
+			t.Errorf("parseDomainOverrides(%q) returned no error", invalid)
+		}
+	}
+}
+
+func TestRemapRegistryAndMounts(t *testing.T) {
+	t.Parallel()
+
+	target := namespaceResources{
+		volumes:    map[string]bool{"data": true},
+		registries: map[string]bool{"gitlab": true},
+	}
+
+	if registry, skipped := remapRegistry("gitlab", target); registry == nil || *registry != "gitlab" || len(skipped) != 0 {
+		t.Errorf("remapRegistry(gitlab) = %v, %v", registry, skipped)
+	}
+	if registry, skipped := remapRegistry("quay", target); registry != nil || len(skipped) != 1 {
+		t.Errorf("remapRegistry(quay) = %v, %v", registry, skipped)
+	}
+	if registry, skipped := remapRegistry("public", target); registry != nil || len(skipped) != 0 {
+		t.Errorf("remapRegistry(public) = %v, %v", registry, skipped)
+	}
+
+	mounts, skipped := remapMounts([]api.ContainerMounts{
+		{Path: "/data", Volume: api.ContainerMountsVolume{Name: "data"}},
+		{Path: "/cache", Volume: api.ContainerMountsVolume{Name: "cache"}},
+	}, target)
+
+	expected := []api.MountInput{{Path: "/data", Volume: api.MountVolumeInput{Name: "data"}, State: api.StatePresent}}
+	if !slices.Equal(mounts, expected) {
+		t.Errorf("remapMounts() = %+v, expected %+v", mounts, expected)
+	}
+	if len(skipped) != 1 {
+		t.Errorf("remapMounts() skipped %v, expected the cache mount", skipped)
+	}
+}
+
+func TestCheckRegistryOnModify(t *testing.T) {
+	t.Parallel()
+
+	gitlab := "gitlab"
+	tests := []struct {
+		name     string
+		current  string
+		registry *string
+		wantErr  bool
+	}{
+		{name: "public target stays public", current: "", registry: nil},
+		{name: "public job target stays public", current: "public", registry: nil},
+		{name: "private target keeps a registry", current: "quay", registry: &gitlab},
+		{name: "private target cannot become public", current: "quay", registry: nil, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := checkRegistryOnModify(tt.current, tt.registry); (err != nil) != tt.wantErr {
+				t.Errorf("checkRegistryOnModify(%q, %v) error = %v, wantErr %v", tt.current, tt.registry, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCopyIngresses(t *testing.T) {
+	t.Parallel()
+
+	source := []api.ContainerResultIngressesIngress{
+		{DomainName: "api.staging.example.com", Port: 80, EnableTLS: true},
+		{DomainName: "admin.staging.example.com", Port: 8080},
+		{DomainName: "metrics.staging.example.com", Port: 9090},
+	}
+	existing := []api.ContainerResultIngressesIngress{{DomainName: "metrics.example.com", Port: 9090}}
+
+	ingresses, skipped := copyIngresses(source, map[int]string{80: "api.example.com"}, existing)
+
+	if len(ingresses) != 2 {
+		t.Fatalf("copyIngresses() returned %d ingresses, expected 2", len(ingresses))
+	}
+	if ingresses[0].DomainName == nil || *ingresses[0].DomainName != "api.example.com" || !ingresses[0].EnableTLS {
+		t.Errorf("copyIngresses() first ingress = %+v", ingresses[0])
+	}
+	if ingresses[1].DomainName != nil || ingresses[1].Port != 8080 {
+		t.Errorf("copyIngresses() second ingress = %+v", ingresses[1])
+	}
+	if len(skipped) != 2 {
+		t.Errorf("copyIngresses() skipped %v, expected the generated domain and the existing port", skipped)
+	}
+}
+
+func TestCopyEnvironment(t *testing.T) {
+	t.Parallel()
+
+	debug := "false"
+	level := "info"
+	source := []api.EnvironmentVariableResult{
+		{Name: "DEBUG", Value: &debug},
+		{Name: "LOG_LEVEL", Value: &level},
+		{Name: "PASSWORD", Secret: true},
+		{Name: "TOKEN", Secret: true},
+	}
+
+	envs, missing := copyEnvironment(source, []string{"DEBUG=true"}, []string{"TOKEN=abc"})
+
+	if expected := []string{"DEBUG=true", "LOG_LEVEL=info"}; !slices.Equal(envs, expected) {
+		t.Errorf("copyEnvironment() envs = %v, expected %v", envs, expected)
+	}
+	if expected := []string{"PASSWORD"}; !slices.Equal(missing, expected) {
+		t.Errorf("copyEnvironment() missing = %v, expected %v", missing, expected)
+	}
+}
+
+func TestScalingFromContainer(t *testing.T) {
+	t.Parallel()
+
+	manual := scalingFromContainer(api.ContainerResult{NumberOfReplicas: 2})
+	if manual == nil || manual.Manual == nil || manual.Manual.Replicas != 2 || manual.Auto != nil {
+		t.Errorf("scalingFromContainer() manual = %+v", manual)
+	}
+
+	auto := scalingFromContainer(api.ContainerResult{
+		NumberOfReplicas: 2,
+		AutoScaling: &api.ContainerResultAutoScaling{
+			Replicas: api.ContainerResultAutoScalingReplicas{Minimum: 1, Maximum: 4},
+			Triggers: []api.ContainerResultAutoScalingTriggersAutoScalingTrigger{{Type: "CPU", Threshold: 80}},
+		},
+	})
+	if auto == nil || auto.Auto == nil || auto.Manual != nil || auto.Auto.Replicas.Maximum != 4 || auto.Auto.Triggers[0].Threshold != 80 {
+		t.Errorf("scalingFromContainer() auto = %+v", auto)
+	}
+
+	if scaling := scalingFromContainer(api.ContainerResult{}); scaling != nil {
+		t.Errorf("scalingFromContainer() without replicas = %+v, expected nil", scaling)
+	}
+}
@@ -0,0 +1,368 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"regexp"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// secretsMarker separates the plain variables from the secrets in the `env edit` view.
+	secretsMarker = "# --- secrets ---"
+	// secretPlaceholder stands in for the value of an existing secret, the API never returns it.
+	secretPlaceholder = "<unchanged>"
+	maskedValue       = "********"
+)
+
+var unquotedDotenvValue = regexp.MustCompile(`^[A-Za-z0-9_./:@+,-]*$`)
+
+// envTarget describes how to read and change the environment of a container or a container job.
+type envTarget struct {
+	kind  string
+	get   func(ctx context.Context, client *api.Client, namespace string, name string) ([]api.EnvironmentVariableResult, error)
+	apply func(ctx context.Context, client *api.Client, namespace string, name string, changes []api.EnvironmentVariableInput) error
+}
+
+var containerEnvTarget = envTarget{
+	kind: "container",
+	get: func(ctx context.Context, client *api.Client, namespace string, name string) ([]api.EnvironmentVariableResult, error) {
+		container, err := client.ListContainerByName(ctx, namespace, name)
+		return container.EnvironmentVariables, err
+	},
+	apply: func(ctx context.Context, client *api.Client, namespace string, name string, changes []api.EnvironmentVariableInput) error {
+		container, err := client.ListContainerByName(ctx, namespace, name)
+		if err != nil {
+			return err
+		}
+
+		input := containerModifyInput(namespace, container)
+		input.EnvironmentVariables = changes
+		_, err = client.ContainerModify(ctx, input)
+		return err
+	},
+}
+
+var containerJobEnvTarget = envTarget{
+	kind: "container job",
+	get: func(ctx context.Context, client *api.Client, namespace string, name string) ([]api.EnvironmentVariableResult, error) {
+		job, err := client.ContainerJobByName(ctx, namespace, name)
+		return job.EnvironmentVariables, err
+	},
+	apply: func(ctx context.Context, client *api.Client, namespace string, name string, changes []api.EnvironmentVariableInput) error {
+		job, err := client.ContainerJobByName(ctx, namespace, name)
+		if err != nil {
+			return err
+		}
+
+		input := containerJobModifyInput(namespace, job)
+		input.EnvironmentVariables = changes
+		_, err = client.ContainerJobModify(ctx, input)
+		return err
+	},
+}
+
+// dotenvQuote quotes a value so parseDotenv returns it unchanged.
+func dotenvQuote(value string) string {
+	if unquotedDotenvValue.MatchString(value) {
+		return value
+	}
+
+	if !strings.Contains(value, "'") {
+		return "'" + value + "'"
+	}
+
+	replacer := strings.NewReplacer(`\`, `\\`, "\n", `\n`, "\r", `\r`, `"`, `\"`, `$`, `\$`)
+	return `"` + replacer.Replace(value) + `"`
+}
+
+// renderEnvironment renders the environment as the dotenv view edited by `env edit`.
+func renderEnvironment(kind string, name string, envs []api.EnvironmentVariableResult) string {
+	var plain, secrets []string
+	for _, env := range envs {
+		if env.Secret {
+			secrets = append(secrets, env.Name+"="+secretPlaceholder)
+			continue
+		}
+
+		value := ""
+		if env.Value != nil {
+			value = *env.Value
+		}
+		plain = append(plain, env.Name+"="+dotenvQuote(value))
+	}
+	slices.Sort(plain)
+	slices.Sort(secrets)
+
+	var view strings.Builder
+	fmt.Fprintf(&view, "# Environment variables of %s %q in dotenv syntax.\n", kind, name)
+	view.WriteString("# Remove a line to unset the variable.\n")
+	for _, line := range plain {
+		view.WriteString(line + "\n")
+	}
+	view.WriteString("\n")
+	fmt.Fprintf(&view, "# Variables below the marker are secrets. Keep %s to leave a secret unchanged.\n", secretPlaceholder)
+	view.WriteString(secretsMarker + "\n")
+	for _, line := range secrets {
+		view.WriteString(line + "\n")
+	}
+	return view.String()
+}
+
+// editedEnvironmentChanges compares the edited view with the current environment.
+func editedEnvironmentChanges(current []api.EnvironmentVariableResult, edited string) ([]api.EnvironmentVariableInput, error) {
+	plainPart, secretPart, _ := strings.Cut(edited, secretsMarker+"\n")
+
+	plain, err := parseDotenv([]byte(plainPart))
+	if err != nil {
+		return nil, err
+	}
+	secrets, err := parseDotenv([]byte(secretPart))
+	if err != nil {
+		return nil, err
+	}
+
+	existingSecrets := map[string]bool{}
+	for _, env := range current {
+		existingSecrets[env.Name] = env.Secret
+	}
+
+	desired := map[string]bool{}
+	var changedSecrets []string
+	for _, secret := range secrets {
+		name, value, _ := strings.Cut(secret, "=")
+		desired[name] = true
+		if value == secretPlaceholder {
+			if !existingSecrets[name] {
+				return nil, fmt.Errorf("%s is not an existing secret, replace %s with a value", name, secretPlaceholder)
+			}
+			continue
+		}
+		changedSecrets = append(changedSecrets, secret)
+	}
+
+	for _, env := range plain {
+		name, _, _ := strings.Cut(env, "=")
+		if desired[name] {
+			return nil, fmt.Errorf("%s is defined both as a variable and as a secret", name)
+		}
+		desired[name] = true
+	}
+
+	var removed []string
+	for _, env := range current {
+		if !desired[env.Name] {
+			removed = append(removed, env.Name)
+		}
+	}
+
+	return diffEnvironment(current, plain, changedSecrets, removed), nil
+}
+
+func printEnvironmentChanges(changes []api.EnvironmentVariableInput) {
+	for _, change := range changes {
+		switch {
+		case change.State == api.StateAbsent:
+			fmt.Printf("- %s\n", change.Name)
+		case change.Secret:
+			fmt.Printf("~ %s (secret)\n", change.Name)
+		default:
+			fmt.Printf("~ %s=%s\n", change.Name, change.Value)
+		}
+	}
+}
+
+// editInEditor opens the content in $VISUAL or $EDITOR and returns the edited content.
+func editInEditor(content string) (string, error) {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+
+	file, err := os.CreateTemp("", "nexaa-env-*.env")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.WriteString(content); err != nil {
+		file.Close()
+		return "", err
+	}
+	file.Close()
+
+	parts := strings.Fields(editor)
+	command := exec.Command(parts[0], append(parts[1:], file.Name())...)
+	command.Stdin = os.Stdin
+	command.Stdout = os.Stdout
+	command.Stderr = os.Stderr
+	if err := command.Run(); err != nil {
+		return "", fmt.Errorf("editor %q failed: %w", editor, err)
+	}
+
+	edited, err := os.ReadFile(file.Name())
+	return string(edited), err
+}
+
+func applyEnvironmentChanges(ctx context.Context, target envTarget, client *api.Client, namespace string, name string, changes []api.EnvironmentVariableInput) {
+	if len(changes) == 0 {
+		fmt.Println("No changes.")
+		return
+	}
+
+	if err := target.apply(ctx, client, namespace, name, changes); err != nil {
+		log.Fatalf("Failed to update environment of %s %q: %v", target.kind, name, err)
+	}
+	printEnvironmentChanges(changes)
+}
+
+// newEnvCmd builds the `env` command with list, set, unset and edit for a container or a container job.
+func newEnvCmd(target envTarget) *cobra.Command {
+	envCmd := &cobra.Command{
+		Use:   "env",
+		Short: fmt.Sprintf("Inspect and edit the environment variables of a %s", target.kind),
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: fmt.Sprintf("List the environment variables of a %s", target.kind),
+		Run: func(cmd *cobra.Command, args []string) {
+			namespace, _ := cmd.Flags().GetString("namespace")
+			name, _ := cmd.Flags().GetString("name")
+			reveal, _ := cmd.Flags().GetBool("reveal")
+
+			envs, err := target.get(cmd.Context(), api.NewClient(), namespace, name)
+			if err != nil {
+				log.Fatalf("Failed to get %s: %v", target.kind, err)
+			}
+
+			masked := maskedValue
+			for i, env := range envs {
+				if env.Secret && (!reveal || env.Value == nil) {
+					envs[i].Value = &masked
+				}
+			}
+			if printOutput(cmd, envs) {
+				return
+			}
+
+			if len(envs) == 0 {
+				fmt.Println("No environment variables found.")
+				return
+			}
+
+			writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.Debug)
+			fmt.Fprintln(writer, "NAME\t VALUE\t SECRET\t")
+			for _, env := range envs {
+				value := ""
+				if env.Value != nil {
+					value = *env.Value
+				}
+				fmt.Fprintf(writer, "%s\t %s\t %s\t\n", env.Name, value, enabledApiToString(env.Secret))
+			}
+			writer.Flush()
+		},
+	}
+	listCmd.Flags().Bool("reveal", false, "Show the values of secrets when the API returns them")
+	addOutputFlag(listCmd)
+
+	setCmd := &cobra.Command{
+		Use:   "set NAME=VALUE...",
+		Short: fmt.Sprintf("Set environment variables of a %s", target.kind),
+		Run: func(cmd *cobra.Command, args []string) {
+			namespace, _ := cmd.Flags().GetString("namespace")
+			name, _ := cmd.Flags().GetString("name")
+			secrets := secretsFromFlags(cmd)
+
+			if len(args) == 0 && len(secrets) == 0 {
+				log.Fatalf("Pass at least one NAME=VALUE or --secret")
+			}
+
+			client := api.NewClient()
+			current, err := target.get(cmd.Context(), client, namespace, name)
+			if err != nil {
+				log.Fatalf("Failed to get %s: %v", target.kind, err)
+			}
+
+			applyEnvironmentChanges(cmd.Context(), target, client, namespace, name, diffEnvironment(current, mergeEnvironment(args), secrets, nil))
+		},
+	}
+	setCmd.Flags().StringArray("secret", []string{}, "Secret to set (NAME=VALUE, NAME=@path to read it from a file or NAME=- to read it from stdin)")
+
+	unsetCmd := &cobra.Command{
+		Use:   "unset NAME...",
+		Short: fmt.Sprintf("Remove environment variables from a %s", target.kind),
+		Args:  cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			namespace, _ := cmd.Flags().GetString("namespace")
+			name, _ := cmd.Flags().GetString("name")
+
+			client := api.NewClient()
+			current, err := target.get(cmd.Context(), client, namespace, name)
+			if err != nil {
+				log.Fatalf("Failed to get %s: %v", target.kind, err)
+			}
+
+			applyEnvironmentChanges(cmd.Context(), target, client, namespace, name, diffEnvironment(current, nil, nil, args))
+		},
+	}
+
+	editCmd := &cobra.Command{
+		Use:   "edit",
+		Short: fmt.Sprintf("Edit the environment variables of a %s in $EDITOR", target.kind),
+		Run: func(cmd *cobra.Command, args []string) {
+			namespace, _ := cmd.Flags().GetString("namespace")
+			name, _ := cmd.Flags().GetString("name")
+
+			client := api.NewClient()
+			current, err := target.get(cmd.Context(), client, namespace, name)
+			if err != nil {
+				log.Fatalf("Failed to get %s: %v", target.kind, err)
+			}
+
+			view := renderEnvironment(target.kind, name, current)
+			edited, err := editInEditor(view)
+			if err != nil {
+				log.Fatalf("Failed to edit environment: %v", err)
+			}
+
+			if edited == view {
+				fmt.Println("No changes.")
+				return
+			}
+
+			changes, err := editedEnvironmentChanges(current, edited)
+			if err != nil {
+				log.Fatalf("Invalid environment: %v", err)
+			}
+
+			applyEnvironmentChanges(cmd.Context(), target, client, namespace, name, changes)
+		},
+	}
+
+	for _, subCmd := range []*cobra.Command{listCmd, setCmd, unsetCmd, editCmd} {
+		subCmd.Flags().StringP("namespace", "n", "", "Namespace")
+		subCmd.Flags().String("name", "", fmt.Sprintf("Name of the %s", target.kind))
+		subCmd.MarkFlagRequired("namespace")
+		subCmd.MarkFlagRequired("name")
+		envCmd.AddCommand(subCmd)
+	}
+
+	return envCmd
+}
+
+func init() {
+	containerCmd.AddCommand(newEnvCmd(containerEnvTarget))
+	containerJobCmd.AddCommand(newEnvCmd(containerJobEnvTarget))
+}
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+func TestDotenvQuoteRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	values := []string{
+		"plain",
+		"https://example.com/path?a=b&c=d",
+		"with spaces # and a hash",
+		"multi\nline",
+		`it's "quoted" and $HOME \ backslash`,
+		"it's\nmultiline",
+		"",
+	}
+
+	for _, value := range values {
+		t.Run(value, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseDotenv([]byte("KEY=" + dotenvQuote(value) + "\n"))
+			if err != nil {
+				t.Fatalf("parseDotenv() error = %v", err)
+			}
+			if len(got) != 1 || got[0] != "KEY="+value {
+				t.Errorf("round trip of %q = %q", value, got)
+			}
+		})
+	}
+}
+
+func TestEditedEnvironmentChanges(t *testing.T) {
+	t.Parallel()
+
+	debug := "false"
+	url := "https://example.com"
+	current := []api.EnvironmentVariableResult{
+		{Name: "DEBUG", Value: &debug},
+		{Name: "URL", Value: &url},
+		{Name: "PASSWORD", Secret: true},
+		{Name: "TOKEN", Secret: true},
+	}
+
+	view := renderEnvironment("container", "web", current)
+
+	tests := []struct {
+		name     string
+		edit     func(string) string
+		expected []api.EnvironmentVariableInput
+		wantErr  bool
+	}{
+		{
+			name:     "unchanged view",
+			edit:     func(view string) string { return view },
+			expected: nil,
+		},
+		{
+			name: "change and remove variables",
+			edit: func(view string) string {
+				view = strings.Replace(view, "DEBUG=false", "DEBUG=true", 1)
+				return strings.Replace(view, "URL=https://example.com\n", "", 1)
+			},
+			expected: []api.EnvironmentVariableInput{
+				{Name: "DEBUG", Value: "true", State: api.StatePresent},
+				{Name: "URL", State: api.StateAbsent},
+			},
+		},
+		{
+			name: "replace and remove secrets",
+			edit: func(view string) string {
+				view = strings.Replace(view, "PASSWORD=<unchanged>", "PASSWORD=new", 1)
+				return strings.Replace(view, "TOKEN=<unchanged>\n", "", 1)
+			},
+			expected: []api.EnvironmentVariableInput{
+				{Name: "PASSWORD", Value: "new", Secret: true, State: api.StatePresent},
+				{Name: "TOKEN", Secret: true, State: api.StateAbsent},
+			},
+		},
+		{
+			name: "add a secret",
+			edit: func(view string) string {
+				return view + "API_KEY=abc\n"
+			},
+			expected: []api.EnvironmentVariableInput{
+				{Name: "API_KEY", Value: "abc", Secret: true, State: api.StatePresent},
+			},
+		},
+		{
+			name: "placeholder for a new secret",
+			edit: func(view string) string {
+				return view + "API_KEY=<unchanged>\n"
+			},
+			wantErr: true,
+		},
+		{
+			name: "variable and secret with the same name",
+			edit: func(view string) string {
+				return strings.Replace(view, "DEBUG=false", "DEBUG=false\nPASSWORD=plain", 1)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := editedEnvironmentChanges(current, tt.edit(view))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("editedEnvironmentChanges() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("editedEnvironmentChanges() = %+v, expected %+v", got, tt.expected)
+			}
+		})
+	}
+}
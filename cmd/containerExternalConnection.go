@@ -78,7 +78,7 @@ var enableContainerExternalConnectionCmd = &cobra.Command{
 
 		client := api.NewClient()
 
-		container, err := client.ContainerModify(resource)
+		container, err := client.ContainerModify(cmd.Context(), resource)
 		if err != nil {
 			log.Fatalf("Failed to enable external connection in cluster %q/%q: %v", namespace, containerName, err)
 			return
@@ -130,7 +130,7 @@ var disableContainerExternalConnectionCmd = &cobra.Command{
 			}
 		}
 
-		container, err := client.ContainerModify(resource)
+		container, err := client.ContainerModify(cmd.Context(), resource)
 
 		if err != nil {
 			log.Fatalf("Failed to disable external connection in cluster %q/%q: %v", namespace, name, err)
@@ -150,13 +150,17 @@ var listContainerExternalConnectionCmd = &cobra.Command{
 		name, _ := cmd.Flags().GetString("name")
 
 		client := api.NewClient()
-		container, err := client.ListContainerByName(namespace, name)
+		container, err := client.ListContainerByName(cmd.Context(), namespace, name)
 
 		if err != nil {
 			log.Fatalf("Container %q/%q not found: %v", namespace, name, err)
 			return
 		}
 
+		if printOutput(cmd, container.ExternalConnection) {
+			return
+		}
+
 		if container.ExternalConnection == nil || len(container.ExternalConnection.Ports) == 0 {
 			log.Printf("No external connections enabled on %q/%q.", namespace, name)
 			return
@@ -192,7 +196,7 @@ func init() {
 	enableContainerExternalConnectionCmd.Flags().StringArray("allowed-ip", []string{"0.0.0.0/0", "::/0"}, "Allowed ip for the connection")
 	enableContainerExternalConnectionCmd.Flags().Int("internal-port", 0, "Internal port to enable external connection on")
 	enableContainerExternalConnectionCmd.Flags().Int("external-port", 0, "Internal port to enable external connection on")
-	enableContainerExternalConnectionCmd.Flags().String("protocol", "TCP", "Protocol for the external connection (tcp or udp)")
+	enableContainerExternalConnectionCmd.Flags().String("protocol", "TCP", "Protocol for the external connection (TCP or UDP)")
 	enableContainerExternalConnectionCmd.MarkFlagRequired("namespace")
 	enableContainerExternalConnectionCmd.MarkFlagRequired("name")
 	enableContainerExternalConnectionCmd.MarkFlagRequired("internal-port")
@@ -209,6 +213,7 @@ func init() {
 	listContainerExternalConnectionCmd.Flags().String("name", "", "Name of the container")
 	listContainerExternalConnectionCmd.MarkFlagRequired("namespace")
 	listContainerExternalConnectionCmd.MarkFlagRequired("name")
+	addOutputFlag(listContainerExternalConnectionCmd)
 	containerEnableExternalConnectionCmd.AddCommand(listContainerExternalConnectionCmd)
 
 }
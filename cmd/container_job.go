@@ -22,13 +22,12 @@ var createContainerJobCmd = &cobra.Command{
 		namespace, _ := cmd.Flags().GetString("namespace")
 		name, _ := cmd.Flags().GetString("name")
 		image, _ := cmd.Flags().GetString("image")
-		resources, _ := cmd.Flags().GetString("resources")
+		resources := resourcesFromFlags(cmd)
 		schedule, _ := cmd.Flags().GetString("schedule")
 		enabled, _ := cmd.Flags().GetBool("enable")
 		command, _ := cmd.Flags().GetStringArray("command")
 		entrypoint, _ := cmd.Flags().GetStringArray("entrypoint")
-		environmentVariables, _ := cmd.Flags().GetStringArray("env")
-		secrets, _ := cmd.Flags().GetStringArray("secret")
+		environmentVariables, secrets := environmentFromFlags(cmd)
 
 		envs := append(envsToApi(environmentVariables, false, api.StatePresent), envsToApi(secrets, true, api.StatePresent)...)
 
@@ -47,7 +46,7 @@ var createContainerJobCmd = &cobra.Command{
 
 		client := api.NewClient()
 
-		containerJob, err := client.ContainerJobCreate(input)
+		containerJob, err := client.ContainerJobCreate(cmd.Context(), input)
 
 		if err != nil {
 			log.Fatalf("Failed to create container job: %v", err)
@@ -65,31 +64,23 @@ var modifyContainerJobCmd = &cobra.Command{
 		namespace, _ := cmd.Flags().GetString("namespace")
 		name, _ := cmd.Flags().GetString("name")
 		image, _ := cmd.Flags().GetString("image")
-		resources, _ := cmd.Flags().GetString("resources")
+		resources := resourcesFromFlags(cmd)
 		schedule, _ := cmd.Flags().GetString("schedule")
 		command, _ := cmd.Flags().GetStringArray("command")
 		entrypoint, _ := cmd.Flags().GetStringArray("entrypoint")
 		enabled, _ := cmd.Flags().GetBool("enable")
-		environmentVariables, _ := cmd.Flags().GetStringArray("env")
-		secrets, _ := cmd.Flags().GetStringArray("secret")
+		environmentVariables, secrets := environmentFromFlags(cmd)
 		removedEnvironmentVariables, _ := cmd.Flags().GetStringArray("remove-env")
 
 		client := api.NewClient()
 
-		oldContainerJob, err := client.ContainerJobByName(namespace, name)
+		oldContainerJob, err := client.ContainerJobByName(cmd.Context(), namespace, name)
 
 		if err != nil {
 			log.Fatalf("Container job not found: %v", err)
 		}
 
-		envs := append(
-			envsToApi(environmentVariables, false, api.StatePresent),
-			envsToApi(secrets, true, api.StatePresent)...,
-		)
-		envs = append(
-			envs,
-			envsToApi(removedEnvironmentVariables, false, api.StateAbsent)...,
-		)
+		envs := diffEnvironment(oldContainerJob.EnvironmentVariables, environmentVariables, secrets, removedEnvironmentVariables)
 
 		input := api.ContainerJobModifyInput{
 			Name:                 name,
@@ -128,7 +119,7 @@ var modifyContainerJobCmd = &cobra.Command{
 			input.Entrypoint = entrypoint
 		}
 
-		containerJob, err := client.ContainerJobModify(input)
+		containerJob, err := client.ContainerJobModify(cmd.Context(), input)
 
 		if err != nil {
 			log.Fatalf("Failed to modify container job: %v", err)
@@ -147,11 +138,15 @@ var getContainerJobCmd = &cobra.Command{
 		name, _ := cmd.Flags().GetString("name")
 		client := api.NewClient()
 
-		containerJob, err := client.ContainerJobByName(namespace, name)
+		containerJob, err := client.ContainerJobByName(cmd.Context(), namespace, name)
 		if err != nil {
 			log.Fatalf("Failed to list containerJob jobs: %v", err)
 		}
 
+		if printOutput(cmd, containerJob) {
+			return
+		}
+
 		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.Debug)
 
 		fmt.Fprintln(writer, "NAME\t STATE\t IMAGE\t ENTRYPOINT\t COMMAND\t ENABLED\t SCHEDULE\t")
@@ -169,12 +164,16 @@ var listContainerJobsCmd = &cobra.Command{
 		namespace, _ := cmd.Flags().GetString("namespace")
 		client := api.NewClient()
 
-		containerJobs, err := client.ContainerJobList(namespace)
+		containerJobs, err := client.ContainerJobList(cmd.Context(), namespace)
 
 		if err != nil {
 			log.Fatalf("Failed to list containerJob jobs: %v", err)
 		}
 
+		if printOutput(cmd, containerJobs) {
+			return
+		}
+
 		if len(containerJobs) == 0 {
 			fmt.Println("No containerjobs found.")
 			return
@@ -206,7 +205,9 @@ var deleteContainerJobCmd = &cobra.Command{
 
 		client := api.NewClient()
 
-		result, err := client.ContainerJobDelete(namespace, name)
+		confirmDeletion(cmd, "container job", name)
+
+		result, err := client.ContainerJobDelete(cmd.Context(), namespace, name)
 		if err != nil {
 			log.Fatalf("Failed to delete container job: %q", err)
 			return
@@ -224,28 +225,30 @@ func init() {
 	createContainerJobCmd.Flags().StringP("namespace", "n", "", "Namespace")
 	createContainerJobCmd.Flags().String("name", "", "Name for this container job")
 	createContainerJobCmd.Flags().String("image", "", "Container job image")
-	createContainerJobCmd.Flags().String("resources", "", "Container job resources")
+	addResourcesFlags(createContainerJobCmd, "Container job resources")
 	createContainerJobCmd.Flags().String("schedule", "", "Container job schedule")
 	createContainerJobCmd.Flags().StringArray("env", []string{}, "Container job environment variables")
-	createContainerJobCmd.Flags().StringArray("secret", []string{}, "Container job secrets")
+	createContainerJobCmd.Flags().StringArray("secret", []string{}, "Container job secrets (NAME=VALUE, NAME=@path to read it from a file or NAME=- to read it from stdin)")
+	addEnvFileFlags(createContainerJobCmd)
 	createContainerJobCmd.Flags().StringArray("command", []string{}, "Container job command")
 	createContainerJobCmd.Flags().StringArray("entrypoint", []string{}, "Container job entrypoint")
 	createContainerJobCmd.Flags().Bool("enable", true, "enable container job")
 	createContainerJobCmd.MarkFlagRequired("namespace")
 	createContainerJobCmd.MarkFlagRequired("name")
 	createContainerJobCmd.MarkFlagRequired("image")
-	createContainerJobCmd.MarkFlagRequired("resources")
+	createContainerJobCmd.MarkFlagsOneRequired("resources", "cpu", "ram")
 	createContainerJobCmd.MarkFlagRequired("schedule")
 	containerJobCmd.AddCommand(createContainerJobCmd)
 
 	modifyContainerJobCmd.Flags().StringP("namespace", "n", "", "Namespace")
 	modifyContainerJobCmd.Flags().String("name", "", "Name for this container job")
 	modifyContainerJobCmd.Flags().String("image", "", "Container job image")
-	modifyContainerJobCmd.Flags().String("resources", "", "Container job resources")
+	addResourcesFlags(modifyContainerJobCmd, "Container job resources")
 	modifyContainerJobCmd.Flags().String("schedule", "", "Container job schedule")
 	modifyContainerJobCmd.Flags().Bool("enable", true, "enable container job")
 	modifyContainerJobCmd.Flags().StringArray("env", []string{}, "Container job environment variables")
-	modifyContainerJobCmd.Flags().StringArray("secret", []string{}, "Container job secrets")
+	modifyContainerJobCmd.Flags().StringArray("secret", []string{}, "Container job secrets (NAME=VALUE, NAME=@path to read it from a file or NAME=- to read it from stdin)")
+	addEnvFileFlags(modifyContainerJobCmd)
 	modifyContainerJobCmd.Flags().StringArray("remove-env", []string{}, "Container job remove environment variables")
 	modifyContainerJobCmd.Flags().StringArray("command", []string{}, "Container job command")
 	modifyContainerJobCmd.Flags().StringArray("entrypoint", []string{}, "Container job entrypoint")
@@ -255,10 +258,12 @@ func init() {
 
 	listContainerJobsCmd.Flags().StringP("namespace", "n", "", "Namespace")
 	listContainerJobsCmd.MarkFlagRequired("namespace")
+	addOutputFlag(listContainerJobsCmd)
 	containerJobCmd.AddCommand(listContainerJobsCmd)
 
 	deleteContainerJobCmd.Flags().StringP("namespace", "n", "", "Namespace")
 	deleteContainerJobCmd.Flags().String("name", "", "Name of the containerjob")
+	addConfirmFlag(deleteContainerJobCmd)
 	deleteContainerJobCmd.MarkFlagRequired("namespace")
 	deleteContainerJobCmd.MarkFlagRequired("name")
 	containerJobCmd.AddCommand(deleteContainerJobCmd)
@@ -267,5 +272,6 @@ func init() {
 	getContainerJobCmd.Flags().String("name", "", "Name of the container job")
 	getContainerJobCmd.MarkFlagRequired("namespace")
 	getContainerJobCmd.MarkFlagRequired("Name")
+	addOutputFlag(getContainerJobCmd)
 	containerJobCmd.AddCommand(getContainerJobCmd)
 }
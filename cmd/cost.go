@@ -0,0 +1,416 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+	"github.com/spf13/cobra"
+)
+
+// costManifest lists the resources to estimate, `cost estimate --file` reads it as JSON.
+type costManifest struct {
+	Containers       []costContainer `json:"containers"`
+	ContainerJobs    []costContainer `json:"containerJobs"`
+	Volumes          []costVolume    `json:"volumes"`
+	DatabaseClusters []costPlan      `json:"databaseClusters"`
+	MessageQueues    []costPlan      `json:"messageQueues"`
+}
+
+type costContainer struct {
+	Name      string                 `json:"name"`
+	Resources api.ContainerResources `json:"resources"`
+	Replicas  int                    `json:"replicas"`
+}
+
+type costVolume struct {
+	Name string  `json:"name"`
+	Size float64 `json:"size"`
+}
+
+type costPlan struct {
+	Name string `json:"name"`
+	Plan string `json:"plan"`
+}
+
+// priceCatalog holds the prices returned by the API. Amounts are monthly and in cents, like Price.amount.
+type priceCatalog struct {
+	specifications []api.ResourceSpecificationResult
+	databasePlans  []api.CloudDatabaseClusterPlan
+	queuePlans     []api.MessageQueuePlanResult
+	// volumePrice is the price in cents per GB per month, the API has no price for volumes.
+	volumePrice float64
+}
+
+// currency returns the currency of the first price fetched, volumes are priced in it. It is empty when no
+// price with a currency was fetched.
+func (catalog priceCatalog) currency() string {
+	for _, specification := range catalog.specifications {
+		if specification.Price.Currency != nil {
+			return *specification.Price.Currency
+		}
+	}
+	for _, plan := range catalog.databasePlans {
+		if plan.Price.Currency != nil {
+			return *plan.Price.Currency
+		}
+	}
+	for _, plan := range catalog.queuePlans {
+		if plan.Price.Currency != nil {
+			return *plan.Price.Currency
+		}
+	}
+	return ""
+}
+
+// costLine is a single resource in the estimate. Monthly is nil when no price is known.
+type costLine struct {
+	Kind      string  `json:"kind"`
+	Name      string  `json:"name"`
+	Spec      string  `json:"spec"`
+	Quantity  float64 `json:"quantity"`
+	UnitPrice *int    `json:"unitPrice"`
+	Monthly   *int    `json:"monthly"`
+	Currency  string  `json:"currency"`
+}
+
+type costEstimate struct {
+	Lines  []costLine     `json:"lines"`
+	Totals map[string]int `json:"totals"`
+}
+
+func (manifest costManifest) empty() bool {
+	return len(manifest.Containers) == 0 && len(manifest.ContainerJobs) == 0 && len(manifest.Volumes) == 0 &&
+		len(manifest.DatabaseClusters) == 0 && len(manifest.MessageQueues) == 0
+}
+
+func formatPrice(amount int, currency string) string {
+	if currency == "" {
+		return fmt.Sprintf("%.2f", float64(amount)/100)
+	}
+	return fmt.Sprintf("%s %.2f", currency, float64(amount)/100)
+}
+
+func priceLine(kind string, name string, spec string, quantity float64, amount *int, currency *string) costLine {
+	line := costLine{Kind: kind, Name: name, Spec: spec, Quantity: quantity}
+	if amount == nil {
+		return line
+	}
+
+	unit := *amount
+	monthly := int(math.Round(float64(unit) * quantity))
+	line.UnitPrice = &unit
+	line.Monthly = &monthly
+	if currency != nil {
+		line.Currency = *currency
+	}
+	return line
+}
+
+func containerCostLine(kind string, container costContainer, catalog priceCatalog) costLine {
+	replicas := float64(max(container.Replicas, 1))
+	specification, ok := api.MatchResourceSpecification(container.Resources, catalog.specifications)
+	if !ok {
+		return costLine{Kind: kind, Name: container.Name, Spec: string(container.Resources), Quantity: replicas}
+	}
+	return priceLine(kind, container.Name, string(container.Resources), replicas, specification.Price.Amount, specification.Price.Currency)
+}
+
+func samePlan(reference string, id string, name string) bool {
+	return reference == id || strings.EqualFold(reference, name)
+}
+
+// estimateCost prices every resource in the manifest and sums the monthly totals per currency.
+func estimateCost(manifest costManifest, catalog priceCatalog) costEstimate {
+	estimate := costEstimate{Lines: []costLine{}, Totals: map[string]int{}}
+
+	for _, container := range manifest.Containers {
+		estimate.Lines = append(estimate.Lines, containerCostLine("container", container, catalog))
+	}
+	for _, job := range manifest.ContainerJobs {
+		job.Replicas = 1
+		estimate.Lines = append(estimate.Lines, containerCostLine("job", job, catalog))
+	}
+	for _, volume := range manifest.Volumes {
+		line := costLine{Kind: "volume", Name: volume.Name, Spec: fmt.Sprintf("%g GB", volume.Size), Quantity: volume.Size}
+		if catalog.volumePrice > 0 {
+			unit := int(math.Round(catalog.volumePrice))
+			monthly := int(math.Round(catalog.volumePrice * volume.Size))
+			line.UnitPrice = &unit
+			line.Monthly = &monthly
+			line.Currency = catalog.currency()
+		}
+		estimate.Lines = append(estimate.Lines, line)
+	}
+	for _, cluster := range manifest.DatabaseClusters {
+		line := costLine{Kind: "database-cluster", Name: cluster.Name, Spec: cluster.Plan, Quantity: 1}
+		for _, plan := range catalog.databasePlans {
+			if samePlan(cluster.Plan, plan.Id, plan.Name) {
+				line = priceLine("database-cluster", cluster.Name, plan.Name, 1, plan.Price.Amount, plan.Price.Currency)
+				break
+			}
+		}
+		estimate.Lines = append(estimate.Lines, line)
+	}
+	for _, queue := range manifest.MessageQueues {
+		line := costLine{Kind: "message-queue", Name: queue.Name, Spec: queue.Plan, Quantity: 1}
+		for _, plan := range catalog.queuePlans {
+			if samePlan(queue.Plan, plan.Id, plan.Name) {
+				line = priceLine("message-queue", queue.Name, plan.Name, 1, plan.Price.Amount, plan.Price.Currency)
+				break
+			}
+		}
+		estimate.Lines = append(estimate.Lines, line)
+	}
+
+	for _, line := range estimate.Lines {
+		if line.Monthly != nil {
+			estimate.Totals[line.Currency] += *line.Monthly
+		}
+	}
+
+	return estimate
+}
+
+// loadPriceCatalog fetches only the prices needed for the manifest.
+func loadPriceCatalog(ctx context.Context, client *api.Client, manifest costManifest, volumePrice float64) (priceCatalog, error) {
+	catalog := priceCatalog{volumePrice: volumePrice * 100}
+	var err error
+
+	if len(manifest.Containers) > 0 || len(manifest.ContainerJobs) > 0 {
+		if catalog.specifications, err = client.ResourceSpecifications(ctx, api.ContainerSpecificationKind); err != nil {
+			return catalog, fmt.Errorf("failed to get resource specifications: %w", err)
+		}
+	}
+	if len(manifest.DatabaseClusters) > 0 {
+		if catalog.databasePlans, err = client.CloudDatabaseClusterListPlans(ctx); err != nil {
+			return catalog, fmt.Errorf("failed to get database cluster plans: %w", err)
+		}
+	}
+	if len(manifest.MessageQueues) > 0 {
+		if catalog.queuePlans, err = client.MessageQueuePlans(ctx); err != nil {
+			return catalog, fmt.Errorf("failed to get message queue plans: %w", err)
+		}
+	}
+
+	return catalog, nil
+}
+
+func printCostEstimate(estimate costEstimate) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.Debug)
+	fmt.Fprintln(writer, "KIND\t NAME\t SPEC\t QUANTITY\t UNIT PRICE\t MONTHLY\t")
+	var unknown []string
+	for _, line := range estimate.Lines {
+		unitPrice, monthly := "unknown", "unknown"
+		if line.Monthly != nil {
+			unitPrice = formatPrice(*line.UnitPrice, line.Currency)
+			monthly = formatPrice(*line.Monthly, line.Currency)
+		} else {
+			unknown = append(unknown, fmt.Sprintf("%s %q (%s)", line.Kind, line.Name, line.Spec))
+		}
+		fmt.Fprintf(writer, "%s\t %s\t %s\t %g\t %s\t %s\t\n", line.Kind, line.Name, line.Spec, line.Quantity, unitPrice, monthly)
+	}
+	writer.Flush()
+
+	currencies := make([]string, 0, len(estimate.Totals))
+	for currency := range estimate.Totals {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+
+	fmt.Println()
+	for _, currency := range currencies {
+		fmt.Printf("Total: %s per month\n", formatPrice(estimate.Totals[currency], currency))
+	}
+	for _, line := range unknown {
+		fmt.Printf("No price known for %s, it is not included in the total.\n", line)
+	}
+}
+
+func readCostManifest(path string) (costManifest, error) {
+	var manifest costManifest
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return manifest, err
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return manifest, fmt.Errorf("invalid manifest %s: %w", path, err)
+	}
+	return manifest, nil
+}
+
+// namespaceCostManifest describes the live resources of a namespace as a manifest.
+func namespaceCostManifest(namespace string, containers []api.ContainerResult, jobs []api.ContainerJobResult, volumes []api.VolumeResult, clusters []api.CloudDatabaseClusterResult, queues []api.MessageQueueResult) costManifest {
+	var manifest costManifest
+
+	for _, container := range containers {
+		manifest.Containers = append(manifest.Containers, costContainer{Name: container.Name, Resources: container.Resources, Replicas: container.NumberOfReplicas})
+	}
+	for _, job := range jobs {
+		manifest.ContainerJobs = append(manifest.ContainerJobs, costContainer{Name: job.Name, Resources: job.Resources})
+	}
+	for _, volume := range volumes {
+		manifest.Volumes = append(manifest.Volumes, costVolume{Name: volume.Name, Size: volume.Size})
+	}
+	for _, cluster := range clusters {
+		if cluster.Namespace.Name == namespace {
+			manifest.DatabaseClusters = append(manifest.DatabaseClusters, costPlan{Name: cluster.Name, Plan: cluster.Plan.Id})
+		}
+	}
+	for _, queue := range queues {
+		if queue.Namespace.Name == namespace {
+			manifest.MessageQueues = append(manifest.MessageQueues, costPlan{Name: queue.Name, Plan: queue.Plan.Id})
+		}
+	}
+
+	return manifest
+}
+
+var costCmd = &cobra.Command{
+	Use:   "cost",
+	Short: "Estimate the monthly cost of resources",
+}
+
+var estimateCostCmd = &cobra.Command{
+	Use:   "estimate",
+	Short: "Estimate the monthly cost of resources before creating them",
+	Long: `Estimate the monthly cost of the resources given by flags, by a JSON manifest or both.
+
+The manifest has the following structure, all lists are optional:
+
+  {
+    "containers": [{"name": "web", "resources": "CPU_250_RAM_500", "replicas": 2}],
+    "containerJobs": [{"name": "backup", "resources": "CPU_250_RAM_500"}],
+    "volumes": [{"name": "data", "size": 10}],
+    "databaseClusters": [{"name": "db", "plan": "PLAN_ID_OR_NAME"}],
+    "messageQueues": [{"name": "queue", "plan": "PLAN_ID_OR_NAME"}]
+  }
+
+Container jobs are priced as a single replica. The API has no price for volumes, pass
+--volume-price to include them.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		file, _ := cmd.Flags().GetString("file")
+		resources, _ := cmd.Flags().GetString("resources")
+		replicas, _ := cmd.Flags().GetInt("replicas")
+		databasePlan, _ := cmd.Flags().GetString("database-plan")
+		queuePlan, _ := cmd.Flags().GetString("queue-plan")
+		volumeSize, _ := cmd.Flags().GetFloat64("volume-size")
+		volumePrice, _ := cmd.Flags().GetFloat64("volume-price")
+
+		var manifest costManifest
+		if file != "" {
+			var err error
+			if manifest, err = readCostManifest(file); err != nil {
+				log.Fatalf("Failed to read manifest: %v", err)
+			}
+		}
+		if resources != "" {
+			manifest.Containers = append(manifest.Containers, costContainer{Name: "-", Resources: api.ContainerResources(resources), Replicas: replicas})
+		}
+		if databasePlan != "" {
+			manifest.DatabaseClusters = append(manifest.DatabaseClusters, costPlan{Name: "-", Plan: databasePlan})
+		}
+		if queuePlan != "" {
+			manifest.MessageQueues = append(manifest.MessageQueues, costPlan{Name: "-", Plan: queuePlan})
+		}
+		if volumeSize > 0 {
+			manifest.Volumes = append(manifest.Volumes, costVolume{Name: "-", Size: volumeSize})
+		}
+
+		if manifest.empty() {
+			log.Fatalf("Nothing to estimate, pass --file or one of --resources, --database-plan, --queue-plan or --volume-size")
+		}
+		for _, container := range slices.Concat(manifest.Containers, manifest.ContainerJobs) {
+			if _, _, err := api.ParseContainerResources(container.Resources); err != nil {
+				log.Fatalf("Invalid resources for %q: %v", container.Name, err)
+			}
+		}
+
+		catalog, err := loadPriceCatalog(cmd.Context(), api.NewClient(), manifest, volumePrice)
+		if err != nil {
+			log.Fatalf("%v", err)
+		}
+
+		estimate := estimateCost(manifest, catalog)
+		if printOutput(cmd, estimate) {
+			return
+		}
+		printCostEstimate(estimate)
+	},
+}
+
+var namespaceCostCmd = &cobra.Command{
+	Use:   "namespace NAME",
+	Short: "Show the monthly cost of the resources in a namespace",
+	Long: `Show the monthly cost of the containers, container jobs, volumes, database clusters and
+message queues in a namespace. Containers are priced at their current number of replicas.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		namespace := args[0]
+		volumePrice, _ := cmd.Flags().GetFloat64("volume-price")
+
+		client := api.NewClient()
+
+		containers, err := client.ListContainers(cmd.Context(), namespace)
+		if err != nil {
+			log.Fatalf("Failed to list containers: %v", err)
+		}
+		jobs, err := client.ContainerJobList(cmd.Context(), namespace)
+		if err != nil {
+			log.Fatalf("Failed to list container jobs: %v", err)
+		}
+		volumes, err := client.ListVolumes(cmd.Context(), namespace)
+		if err != nil {
+			log.Fatalf("Failed to list volumes: %v", err)
+		}
+		clusters, err := client.CloudDatabaseClusterList(cmd.Context())
+		if err != nil {
+			log.Fatalf("Failed to list database clusters: %v", err)
+		}
+		queues, err := client.MessageQueueList(cmd.Context())
+		if err != nil {
+			log.Fatalf("Failed to list message queues: %v", err)
+		}
+
+		manifest := namespaceCostManifest(namespace, containers, jobs, volumes, clusters, queues)
+		if manifest.empty() {
+			fmt.Printf("No resources found in namespace %q.\n", namespace)
+			return
+		}
+
+		catalog, err := loadPriceCatalog(cmd.Context(), client, manifest, volumePrice)
+		if err != nil {
+			log.Fatalf("%v", err)
+		}
+
+		estimate := estimateCost(manifest, catalog)
+		if printOutput(cmd, estimate) {
+			return
+		}
+		printCostEstimate(estimate)
+	},
+}
+
+func init() {
+	estimateCostCmd.Flags().StringP("file", "f", "", "JSON manifest with the resources to estimate")
+	estimateCostCmd.Flags().String("resources", "", "Resources of a container (e.g. CPU_250_RAM_500)")
+	estimateCostCmd.Flags().Int("replicas", 1, "Number of replicas of the container")
+	estimateCostCmd.Flags().String("database-plan", "", "ID or name of a database cluster plan")
+	estimateCostCmd.Flags().String("queue-plan", "", "ID or name of a message queue plan")
+	estimateCostCmd.Flags().Float64("volume-size", 0, "Size of a volume in GB")
+
+	for _, subCmd := range []*cobra.Command{estimateCostCmd, namespaceCostCmd} {
+		subCmd.Flags().Float64("volume-price", 0, "Price per GB per month of volumes in the currency of the API prices, volumes are left out of the total when not set")
+		addOutputFlag(subCmd)
+		costCmd.AddCommand(subCmd)
+	}
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+func price(amount int) (*int, *string) {
+	currency := "EUR"
+	return &amount, &currency
+}
+
+func TestEstimateCost(t *testing.T) {
+	t.Parallel()
+
+	smallAmount, currency := price(1000)
+	planAmount, _ := price(2500)
+	queueAmount, _ := price(1500)
+
+	catalog := priceCatalog{
+		specifications: []api.ResourceSpecificationResult{
+			{Id: "1", Cpu: 0.25, Ram: 0.5, Price: api.ResourceSpecificationResultPrice{Amount: smallAmount, Currency: currency}},
+		},
+		databasePlans: []api.CloudDatabaseClusterPlan{
+			{Id: "db-small", Name: "Small", Price: api.CloudDatabaseClusterPlanPrice{Amount: planAmount, Currency: currency}},
+		},
+		queuePlans: []api.MessageQueuePlanResult{
+			{Id: "mq-small", Name: "Small", Price: api.MessageQueuePlanResultPrice{Amount: queueAmount, Currency: currency}},
+		},
+		volumePrice: 15,
+	}
+
+	manifest := costManifest{
+		Containers: []costContainer{
+			{Name: "web", Resources: api.ContainerResourcesCpu250Ram500, Replicas: 3},
+			{Name: "big", Resources: api.ContainerResourcesCpu4000Ram16000},
+		},
+		ContainerJobs:    []costContainer{{Name: "backup", Resources: api.ContainerResourcesCpu250Ram500, Replicas: 5}},
+		Volumes:          []costVolume{{Name: "data", Size: 10}},
+		DatabaseClusters: []costPlan{{Name: "db", Plan: "small"}},
+		MessageQueues:    []costPlan{{Name: "queue", Plan: "mq-small"}, {Name: "other", Plan: "unknown"}},
+	}
+
+	estimate := estimateCost(manifest, catalog)
+
+	expected := map[string]int{"web": 3000, "backup": 1000, "data": 150, "db": 2500, "queue": 1500}
+	for _, line := range estimate.Lines {
+		amount, known := expected[line.Name]
+		if !known {
+			if line.Monthly != nil {
+				t.Errorf("estimateCost() priced %s %q at %d, expected unknown", line.Kind, line.Name, *line.Monthly)
+			}
+			continue
+		}
+		if line.Monthly == nil || *line.Monthly != amount {
+			t.Errorf("estimateCost() priced %s %q at %v, expected %d", line.Kind, line.Name, line.Monthly, amount)
+		}
+	}
+
+	if len(estimate.Lines) != 7 {
+		t.Errorf("estimateCost() returned %d lines, expected 7", len(estimate.Lines))
+	}
+	if estimate.Totals["EUR"] != 8150 {
+		t.Errorf("estimateCost() total = %v, expected EUR 8150", estimate.Totals)
+	}
+}
+
+func TestEstimateCostWithoutVolumePrice(t *testing.T) {
+	t.Parallel()
+
+	estimate := estimateCost(costManifest{Volumes: []costVolume{{Name: "data", Size: 10}}}, priceCatalog{})
+
+	if estimate.Lines[0].Monthly != nil || len(estimate.Totals) != 0 {
+		t.Errorf("estimateCost() without a volume price = %+v, expected an unknown price", estimate)
+	}
+}
+
+func TestEstimateCostVolumeCurrency(t *testing.T) {
+	t.Parallel()
+
+	manifest := costManifest{Volumes: []costVolume{{Name: "data", Size: 10}}}
+
+	amount := 2500
+	usd := "USD"
+	catalog := priceCatalog{
+		queuePlans:  []api.MessageQueuePlanResult{{Id: "mq-small", Price: api.MessageQueuePlanResultPrice{Amount: &amount, Currency: &usd}}},
+		volumePrice: 15,
+	}
+	estimate := estimateCost(manifest, catalog)
+	if line := estimate.Lines[0]; line.Currency != "USD" || estimate.Totals["USD"] != 150 {
+		t.Errorf("estimateCost() volume line = %+v, totals %v, expected USD 150", line, estimate.Totals)
+	}
+
+	estimate = estimateCost(manifest, priceCatalog{volumePrice: 15})
+	if line := estimate.Lines[0]; line.Currency != "" || line.Monthly == nil || *line.Monthly != 150 {
+		t.Errorf("estimateCost() volume line without a fetched currency = %+v, expected 150 without a currency", line)
+	}
+}
+
+func TestNamespaceCostManifest(t *testing.T) {
+	t.Parallel()
+
+	clusters := []api.CloudDatabaseClusterResult{
+		{Name: "db", Namespace: api.CloudDatabaseClusterResultNamespace{Name: "production"}, Plan: api.CloudDatabaseClusterResultPlan{Id: "db-small"}},
+		{Name: "other", Namespace: api.CloudDatabaseClusterResultNamespace{Name: "staging"}},
+	}
+
+	manifest := namespaceCostManifest(
+		"production",
+		[]api.ContainerResult{{Name: "web", Resources: api.ContainerResourcesCpu250Ram500, NumberOfReplicas: 2}},
+		nil,
+		[]api.VolumeResult{{Name: "data", Size: 10}},
+		clusters,
+		nil,
+	)
+
+	if len(manifest.Containers) != 1 || manifest.Containers[0].Replicas != 2 {
+		t.Errorf("namespaceCostManifest() containers = %+v", manifest.Containers)
+	}
+	if len(manifest.DatabaseClusters) != 1 || manifest.DatabaseClusters[0].Plan != "db-small" {
+		t.Errorf("namespaceCostManifest() database clusters = %+v, expected only the cluster in production", manifest.DatabaseClusters)
+	}
+	if len(manifest.Volumes) != 1 || manifest.Volumes[0].Size != 10 {
+		t.Errorf("namespaceCostManifest() volumes = %+v", manifest.Volumes)
+	}
+}
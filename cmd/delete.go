@@ -0,0 +1,231 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// addConfirmFlag registers the --yes flag used by confirmDeletion.
+func addConfirmFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+}
+
+// confirmDeletion asks the user to type the name of the resource before it is deleted.
+// Without a terminal the deletion is refused unless --yes is passed.
+func confirmDeletion(cmd *cobra.Command, kind string, name string) {
+	if yes, _ := cmd.Flags().GetBool("yes"); yes {
+		return
+	}
+
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		log.Fatalf("Refusing to delete %s %q without confirmation, pass --yes to delete it non-interactively", kind, name)
+	}
+
+	fmt.Printf("This will delete %s %q. Type the name of the %s to confirm: ", kind, name, kind)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		log.Fatalf("Failed to read confirmation: %v", err)
+	}
+
+	if strings.TrimSpace(answer) != name {
+		log.Fatalf("Confirmation did not match, %s %q was not deleted", kind, name)
+	}
+}
+
+// refuseWithDependents stops the deletion when other resources still depend on the resource.
+func refuseWithDependents(kind string, name string, dependents []string, cascadable bool) {
+	if len(dependents) == 0 {
+		return
+	}
+
+	message := fmt.Sprintf("Cannot delete %s %q, it is still used by:\n  %s", kind, name, strings.Join(dependents, "\n  "))
+	if cascadable {
+		message += "\nPass --cascade to remove these first."
+	}
+	log.Fatal(message)
+}
+
+// registryUsers returns the containers and jobs that pull their image from the registry.
+func registryUsers(registry string, containers []api.ContainerResult, jobs []api.ContainerJobResult) ([]api.ContainerResult, []api.ContainerJobResult) {
+	var usedByContainers []api.ContainerResult
+	for _, container := range containers {
+		if container.PrivateRegistry != nil && container.PrivateRegistry.Name == registry {
+			usedByContainers = append(usedByContainers, container)
+		}
+	}
+
+	var usedByJobs []api.ContainerJobResult
+	for _, job := range jobs {
+		if job.PrivateRegistry != nil && job.PrivateRegistry.Name == registry {
+			usedByJobs = append(usedByJobs, job)
+		}
+	}
+	return usedByContainers, usedByJobs
+}
+
+func registryDependents(registry string, containers []api.ContainerResult, jobs []api.ContainerJobResult) []string {
+	containers, jobs = registryUsers(registry, containers, jobs)
+
+	var dependents []string
+	for _, container := range containers {
+		dependents = append(dependents, "container/"+container.Name)
+	}
+	for _, job := range jobs {
+		dependents = append(dependents, "job/"+job.Name)
+	}
+	return dependents
+}
+
+// hostnameDependents lists the containers and jobs with an environment variable referencing the hostname.
+// Secret values are not returned by the API, so only plain environment variables are checked.
+func hostnameDependents(hostname string, containers []api.ContainerResult, jobs []api.ContainerJobResult) []string {
+	if hostname == "" {
+		return nil
+	}
+
+	references := func(envs []api.EnvironmentVariableResult) bool {
+		for _, env := range envs {
+			if env.Value != nil && strings.Contains(*env.Value, hostname) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var dependents []string
+	for _, container := range containers {
+		if references(container.EnvironmentVariables) {
+			dependents = append(dependents, "container/"+container.Name)
+		}
+	}
+	for _, job := range jobs {
+		if references(job.EnvironmentVariables) {
+			dependents = append(dependents, "job/"+job.Name)
+		}
+	}
+	return dependents
+}
+
+func namespaceDependents(namespace api.NamespaceResult) []string {
+	var dependents []string
+	for _, container := range namespace.Containers {
+		dependents = append(dependents, "container/"+container.Name)
+	}
+	for _, job := range namespace.ContainerJobs {
+		dependents = append(dependents, "job/"+job.Name)
+	}
+	for _, queue := range namespace.MessageQueues {
+		dependents = append(dependents, "queue/"+queue.Name)
+	}
+	for _, cluster := range namespace.CloudDatabaseClusters {
+		dependents = append(dependents, "databasecluster/"+cluster.Name)
+	}
+	for _, volume := range namespace.Volumes {
+		dependents = append(dependents, "volume/"+volume.Name)
+	}
+	for _, registry := range namespace.PrivateRegistries {
+		dependents = append(dependents, "registry/"+registry.Name)
+	}
+	return dependents
+}
+
+func unmountFromContainer(ctx context.Context, client *api.Client, namespace string, name string, volume string) error {
+	container, err := client.ListContainerByName(ctx, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	var mounts []api.MountInput
+	for _, mount := range container.Mounts {
+		if mount.Volume.Name == volume {
+			mounts = append(mounts, api.MountInput{
+				Path:   mount.Path,
+				Volume: api.MountVolumeInput{Name: volume},
+				State:  api.StateAbsent,
+			})
+		}
+	}
+
+	input := containerModifyInput(namespace, container)
+	input.Mounts = mounts
+
+	_, err = client.ContainerModify(ctx, input)
+	return err
+}
+
+func unmountFromContainerJob(ctx context.Context, client *api.Client, namespace string, name string, volume string) error {
+	job, err := client.ContainerJobByName(ctx, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	var mounts []api.MountInput
+	for _, mount := range job.Mounts {
+		if mount.Volume.Name == volume {
+			mounts = append(mounts, api.MountInput{
+				Path:   mount.Path,
+				Volume: api.MountVolumeInput{Name: volume},
+				State:  api.StateAbsent,
+			})
+		}
+	}
+
+	input := containerJobModifyInput(namespace, job)
+	input.Mounts = mounts
+
+	_, err = client.ContainerJobModify(ctx, input)
+	return err
+}
+
+// waitForSettled polls the containers and jobs until none of them is still rolling out a change.
+func waitForSettled(ctx context.Context, client *api.Client, namespace string, containers []string, jobs []string, timeout time.Duration) error {
+	return waitUntil(timeout, func() (bool, error) {
+		for _, name := range containers {
+			container, err := client.ListContainerByName(ctx, namespace, name)
+			if err != nil {
+				return false, err
+			}
+			if isTransitionalState(container.State) {
+				return false, nil
+			}
+		}
+		for _, name := range jobs {
+			job, err := client.ContainerJobByName(ctx, namespace, name)
+			if err != nil {
+				return false, err
+			}
+			if isTransitionalState(job.State) {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+// waitUntil polls the condition every five seconds until it holds or the timeout passes.
+func waitUntil(timeout time.Duration, condition func() (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		done, err := condition()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %s", timeout)
+		}
+		time.Sleep(5 * time.Second)
+	}
+}
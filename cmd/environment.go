@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+	"github.com/spf13/cobra"
+)
+
+// dotenvEscapes are the escape sequences of double quoted dotenv values.
+var dotenvEscapes = strings.NewReplacer(`\\`, `\`, `\n`, "\n", `\r`, "\r", `\"`, `"`, `\$`, `$`)
+
+// parseDotenv parses dotenv syntax (quotes, multiline values, comments and `export`) into NAME=VALUE pairs sorted by name.
+// Unlike godotenv it does not expand `$VAR`, values are sent to the API exactly as written in the file.
+func parseDotenv(data []byte) ([]string, error) {
+	values := map[string]string{}
+	input := strings.ReplaceAll(string(data), "\r\n", "\n")
+
+	for input != "" {
+		var line string
+		line, input, _ = strings.Cut(input, "\n")
+		line = strings.TrimLeft(line, " \t")
+		if trimmed := strings.TrimSpace(line); trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		name, value, ok := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" || strings.ContainsAny(name, " \t") {
+			return nil, fmt.Errorf("invalid line %q, expected NAME=VALUE", strings.TrimSpace(line))
+		}
+		value = strings.TrimLeft(value, " \t")
+
+		if value == "" || (value[0] != '"' && value[0] != '\'') {
+			if i := strings.Index(value, " #"); i >= 0 {
+				value = value[:i]
+			}
+			values[name] = strings.TrimSpace(value)
+			continue
+		}
+
+		// A quoted value can span lines, so the closing quote is searched in the rest of the input.
+		quote := value[0]
+		value = value[1:] + "\n" + input
+		end := closingQuote(value, quote)
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated quoted value of %s", name)
+		}
+
+		var trailing string
+		trailing, input, _ = strings.Cut(value[end+1:], "\n")
+		if trailing = strings.TrimSpace(trailing); trailing != "" && !strings.HasPrefix(trailing, "#") {
+			return nil, fmt.Errorf("unexpected %q after the quoted value of %s", trailing, name)
+		}
+
+		value = value[:end]
+		if quote == '"' {
+			value = dotenvEscapes.Replace(value)
+		}
+		values[name] = value
+	}
+
+	envs := make([]string, 0, len(values))
+	for name, value := range values {
+		envs = append(envs, name+"="+value)
+	}
+	slices.Sort(envs)
+	return envs, nil
+}
+
+// closingQuote returns the index of the quote that ends value, a double quote can be escaped with a backslash.
+func closingQuote(value string, quote byte) int {
+	for i := 0; i < len(value); i++ {
+		switch {
+		case value[i] == '\\' && quote == '"':
+			i++
+		case value[i] == quote:
+			return i
+		}
+	}
+	return -1
+}
+
+func readEnvFiles(paths []string) ([]string, error) {
+	var envs []string
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		parsed, err := parseDotenv(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		envs = append(envs, parsed...)
+	}
+	return envs, nil
+}
+
+// mergeEnvironment combines NAME=VALUE lists, a later value for the same name replaces an earlier one.
+func mergeEnvironment(lists ...[]string) []string {
+	var merged []string
+	index := map[string]int{}
+
+	for _, list := range lists {
+		for _, env := range list {
+			name, _, _ := strings.Cut(env, "=")
+			if i, ok := index[name]; ok {
+				merged[i] = env
+				continue
+			}
+			index[name] = len(merged)
+			merged = append(merged, env)
+		}
+	}
+	return merged
+}
+
+// addEnvFileFlags registers --env-file and --secret-file.
+func addEnvFileFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArray("env-file", []string{}, "Read environment variables from a dotenv file (repeatable)")
+	cmd.Flags().StringArray("secret-file", []string{}, "Read secrets from a dotenv file (repeatable)")
+}
+
+// environmentFromFlags returns the environment variables and secrets from the files and the --env and --secret flags,
+// values passed as flags take precedence over the files.
+func environmentFromFlags(cmd *cobra.Command) ([]string, []string) {
+	envFiles, _ := cmd.Flags().GetStringArray("env-file")
+	secretFiles, _ := cmd.Flags().GetStringArray("secret-file")
+	environmentVariables, _ := cmd.Flags().GetStringArray("env")
+
+	fileEnvs, err := readEnvFiles(envFiles)
+	if err != nil {
+		log.Fatalf("Failed to read env file: %v", err)
+	}
+	fileSecrets, err := readEnvFiles(secretFiles)
+	if err != nil {
+		log.Fatalf("Failed to read secret file: %v", err)
+	}
+
+	return mergeEnvironment(fileEnvs, environmentVariables), mergeEnvironment(fileSecrets, secretsFromFlags(cmd))
+}
+
+// diffEnvironment returns the minimal changes to go from the current environment to the requested one.
+// Plain variables that already have the same value are skipped, as are removals of unknown variables.
+// Secret values are never returned by the API, so secrets are always sent.
+func diffEnvironment(current []api.EnvironmentVariableResult, envs []string, secrets []string, removed []string) []api.EnvironmentVariableInput {
+	existing := map[string]api.EnvironmentVariableResult{}
+	for _, env := range current {
+		existing[env.Name] = env
+	}
+
+	var changes []api.EnvironmentVariableInput
+	for _, env := range envsToApi(envs, false, api.StatePresent) {
+		old, ok := existing[env.Name]
+		if ok && !old.Secret && old.Value != nil && *old.Value == env.Value {
+			continue
+		}
+		changes = append(changes, env)
+	}
+
+	changes = append(changes, envsToApi(secrets, true, api.StatePresent)...)
+
+	for _, env := range envsToApi(removed, false, api.StateAbsent) {
+		if old, ok := existing[env.Name]; ok {
+			env.Secret = old.Secret
+			changes = append(changes, env)
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+func TestParseDotenv(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "comments and blank lines are ignored",
+			input:    "# comment\n\nA=1\nB=2 # trailing comment\n",
+			expected: []string{"A=1", "B=2"},
+		},
+		{
+			name:     "export prefix",
+			input:    "export TOKEN=abc\n",
+			expected: []string{"TOKEN=abc"},
+		},
+		{
+			name:     "quoted values",
+			input:    "SINGLE='a # b'\nDOUBLE=\"line\\nbreak\"\n",
+			expected: []string{"DOUBLE=line\nbreak", "SINGLE=a # b"},
+		},
+		{
+			name:     "multiline value",
+			input:    "KEY=\"-----BEGIN KEY-----\nabc\n-----END KEY-----\"\n",
+			expected: []string{"KEY=-----BEGIN KEY-----\nabc\n-----END KEY-----"},
+		},
+		{
+			name:     "values containing equals signs",
+			input:    "URL=https://example.com/?a=b\nB64=dGVzdA==\n",
+			expected: []string{"B64=dGVzdA==", "URL=https://example.com/?a=b"},
+		},
+		{
+			name:     "dollar signs are not expanded",
+			input:    "A=pa$HOME\nB='x$USER1y'\nC=\"x$USER1y ${PATH}\"\nD=\"\\$HOME\"\n",
+			expected: []string{"A=pa$HOME", "B=x$USER1y", "C=x$USER1y ${PATH}", "D=$HOME"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseDotenv([]byte(tt.input))
+			if err != nil {
+				t.Fatalf("parseDotenv() error = %v", err)
+			}
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("parseDotenv() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseDotenvErrors(t *testing.T) {
+	t.Parallel()
+
+	inputs := []string{
+		"NAME\n",
+		"KEY=\"unterminated\n",
+		"KEY='value' trailing\n",
+	}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := parseDotenv([]byte(input)); err == nil {
+				t.Errorf("parseDotenv(%q) expected an error", input)
+			}
+		})
+	}
+}
+
+func TestMergeEnvironment(t *testing.T) {
+	t.Parallel()
+
+	got := mergeEnvironment([]string{"A=file", "B=file"}, []string{"B=flag", "C=flag"})
+	expected := []string{"A=file", "B=flag", "C=flag"}
+
+	if !slices.Equal(got, expected) {
+		t.Errorf("mergeEnvironment() = %v, expected %v", got, expected)
+	}
+}
+
+func TestDiffEnvironment(t *testing.T) {
+	t.Parallel()
+
+	same := "same"
+	old := "old"
+	current := []api.EnvironmentVariableResult{
+		{Name: "UNCHANGED", Value: &same},
+		{Name: "CHANGED", Value: &old},
+		{Name: "PASSWORD", Secret: true},
+	}
+
+	tests := []struct {
+		name     string
+		envs     []string
+		secrets  []string
+		removed  []string
+		expected []api.EnvironmentVariableInput
+	}{
+		{
+			name: "unchanged values are skipped",
+			envs: []string{"UNCHANGED=same", "CHANGED=new", "ADDED=1"},
+			expected: []api.EnvironmentVariableInput{
+				{Name: "CHANGED", Value: "new", State: api.StatePresent},
+				{Name: "ADDED", Value: "1", State: api.StatePresent},
+			},
+		},
+		{
+			name:    "secrets are always sent",
+			secrets: []string{"PASSWORD=new"},
+			expected: []api.EnvironmentVariableInput{
+				{Name: "PASSWORD", Value: "new", Secret: true, State: api.StatePresent},
+			},
+		},
+		{
+			name:    "only existing variables are removed",
+			removed: []string{"PASSWORD", "UNKNOWN"},
+			expected: []api.EnvironmentVariableInput{
+				{Name: "PASSWORD", Secret: true, State: api.StateAbsent},
+			},
+		},
+		{
+			name:     "no changes",
+			envs:     []string{"UNCHANGED=same"},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diffEnvironment(current, tt.envs, tt.secrets, tt.removed)
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("diffEnvironment() = %+v, expected %+v", got, tt.expected)
+			}
+		})
+	}
+}
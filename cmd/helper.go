@@ -1,8 +1,10 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/nexaa-cloud/nexaa-cli/api"
 )
@@ -30,13 +32,7 @@ func enabledApiToString(enabled bool) string {
 func envsToApi(environmentVariables []string, secret bool, state api.State) []api.EnvironmentVariableInput {
 	var envs []api.EnvironmentVariableInput
 	for _, env := range environmentVariables {
-		parts := strings.Split(env, "=")
-		name := parts[0]
-
-		var value string
-		if len(parts) != 1 {
-			value = parts[1]
-		}
+		name, value, _ := strings.Cut(env, "=")
 		envs = append(envs, api.EnvironmentVariableInput{
 			Name:   name,
 			Value:  value,
@@ -59,3 +55,90 @@ func splitAndTrim(input string) []string {
 	}
 	return result
 }
+
+// containerModifyInput returns a modify input that keeps the current resources and registry of the container,
+// the same way `container modify` does.
+func containerModifyInput(namespace string, container api.ContainerResult) api.ContainerModifyInput {
+	resources := container.Resources
+	input := api.ContainerModifyInput{
+		Name:      container.Name,
+		Namespace: namespace,
+		Resources: &resources,
+	}
+
+	if container.PrivateRegistry != nil {
+		input.Registry = &container.PrivateRegistry.Name
+	}
+
+	return input
+}
+
+// containerJobModifyInput returns a modify input that keeps the current image, resources, schedule,
+// enabled state and registry of the container job.
+func containerJobModifyInput(namespace string, job api.ContainerJobResult) api.ContainerJobModifyInput {
+	resources := job.Resources
+	input := api.ContainerJobModifyInput{
+		Name:      job.Name,
+		Namespace: namespace,
+		Resources: &resources,
+		Image:     &job.Image,
+		Schedule:  &job.Schedule,
+		Enabled:   &job.Enabled,
+	}
+
+	if job.PrivateRegistry != nil && job.PrivateRegistry.Name != "public" {
+		input.Registry = &job.PrivateRegistry.Name
+	}
+
+	return input
+}
+
+// setContainerSecret stores a single secret environment variable on a container.
+func setContainerSecret(ctx context.Context, client *api.Client, namespace string, name string, key string, value string) (api.ContainerResult, error) {
+	container, err := client.ListContainerByName(ctx, namespace, name)
+	if err != nil {
+		return api.ContainerResult{}, err
+	}
+
+	input := containerModifyInput(namespace, container)
+	input.EnvironmentVariables = []api.EnvironmentVariableInput{
+		{
+			Name:   key,
+			Value:  value,
+			Secret: true,
+			State:  api.StatePresent,
+		},
+	}
+
+	return client.ContainerModify(ctx, input)
+}
+
+// isTransitionalState reports whether a resource state indicates pending work on the platform.
+func isTransitionalState(state string) bool {
+	switch strings.ToUpper(state) {
+	case "CREATE_REQUESTED", "CREATING", "UPDATING", "DELETE_PENDING", "DELETE_REQUESTED", "DELETING":
+		return true
+	}
+	return false
+}
+
+// waitForContainerReplicas polls a container until all of its replicas are available again.
+func waitForContainerReplicas(ctx context.Context, client *api.Client, namespace string, name string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		time.Sleep(5 * time.Second)
+
+		container, err := client.ListContainerByName(ctx, namespace, name)
+		if err != nil {
+			return err
+		}
+
+		if !isTransitionalState(container.State) && container.AvailableReplicas >= container.NumberOfReplicas {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %s, %d/%d replicas available", timeout, container.AvailableReplicas, container.NumberOfReplicas)
+		}
+	}
+}
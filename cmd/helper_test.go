@@ -139,6 +139,26 @@ func TestEnvsToApi(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "value containing equals signs",
+			envs:   []string{"TOKEN=dGVzdA==", "URL=https://example.com/?a=b"},
+			secret: true,
+			state:  api.StatePresent,
+			expectedEnvs: []api.EnvironmentVariableInput{
+				{
+					Name:   "TOKEN",
+					Value:  "dGVzdA==",
+					Secret: true,
+					State:  api.StatePresent,
+				},
+				{
+					Name:   "URL",
+					Value:  "https://example.com/?a=b",
+					Secret: true,
+					State:  api.StatePresent,
+				},
+			},
+		},
 		{
 			name:         "empty environment variables",
 			envs:         []string{},
@@ -21,7 +21,7 @@ var loginCmd = &cobra.Command{
 		username, _ := cmd.Flags().GetString("username")
 
 		envPassword := os.Getenv("TILAA_PASSWORD")
-		password, _ := cmd.Flags().GetString("password")
+		password := passwordFromFlags(cmd)
 
 		if password == "" {
 			password = envPassword
@@ -55,4 +55,5 @@ var loginCmd = &cobra.Command{
 func init() {
 	loginCmd.Flags().StringP("username", "u", "", "Username for authentication")
 	loginCmd.Flags().StringP("password", "p", "", "Password for authentication (optional, will be prompted if not provided)")
+	addPasswordStdinFlag(loginCmd)
 }
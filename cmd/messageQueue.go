@@ -21,10 +21,14 @@ var listMessageQueuesCmd = &cobra.Command{
 	Short: "List all message queues",
 	Run: func(cmd *cobra.Command, args []string) {
 		client := api.NewClient()
-		queues, err := client.MessageQueueList()
+		queues, err := client.MessageQueueList(cmd.Context())
 		if err != nil {
 			log.Fatalf("Failed to list message queues: %v", err)
 		}
+
+		if printOutput(cmd, queues) {
+			return
+		}
 		if len(queues) == 0 {
 			fmt.Println("No message queues found.")
 			return
@@ -54,14 +58,23 @@ var getMessageQueueCmd = &cobra.Command{
 			Namespace: namespace,
 		}
 
-		queue, err := client.MessageQueueGet(input)
+		queue, err := client.MessageQueueGet(cmd.Context(), input)
 		if err != nil {
 			log.Fatalf("Failed to get message queue: %v", err)
 		}
 
+		if printOutput(cmd, queue) {
+			return
+		}
+
+		var adminUser string
+		if queue.AdminUser != nil {
+			adminUser = queue.AdminUser.Name
+		}
+
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.Debug)
-		fmt.Fprintln(w, "NAME\t NAMESPACE\t STATE\t LOCKED\t ADMIN USER\t")
-		fmt.Fprintf(w, "%s\t %s\t %s\t %t\t %s\t \n", queue.Name, queue.Namespace.Name, queue.State, queue.Locked, queue.AdminUser.Name)
+		fmt.Fprintln(w, "NAME\t NAMESPACE\t STATE\t LOCKED\t ADMIN USER\t DOMAIN\t INGRESS STATUS\t TLS\t")
+		fmt.Fprintf(w, "%s\t %s\t %s\t %t\t %s\t %s\t %s\t %s\t \n", queue.Name, queue.Namespace.Name, queue.State, queue.Locked, adminUser, queue.Ingress.DomainName, queue.Ingress.Status, enabledApiToString(queue.Ingress.TlsEnabled))
 		w.Flush()
 	},
 }
@@ -72,7 +85,6 @@ var createMessageQueueCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		namespace, _ := cmd.Flags().GetString("namespace")
 		name, _ := cmd.Flags().GetString("name")
-		plan, _ := cmd.Flags().GetString("plan")
 		queueType, _ := cmd.Flags().GetString("type")
 		version, _ := cmd.Flags().GetString("version")
 		allowlistStr, _ := cmd.Flags().GetString("allowlist")
@@ -101,10 +113,16 @@ var createMessageQueueCmd = &cobra.Command{
 			allowList = allowListDefault
 		}
 
+		client := api.NewClient()
+		plans, err := client.MessageQueuePlans(cmd.Context())
+		if err != nil {
+			log.Fatalf("Failed to list message queue plans: %v", err)
+		}
+
 		input := api.MessageQueueCreateInput{
 			Name:      name,
 			Namespace: namespace,
-			Plan:      plan,
+			Plan:      planFromFlags(cmd, queuePlans(plans)),
 			Spec: api.MessageQueueSpecInput{
 				Type:    queueType,
 				Version: version,
@@ -112,8 +130,7 @@ var createMessageQueueCmd = &cobra.Command{
 			AllowList: allowList,
 		}
 
-		client := api.NewClient()
-		queue, err := client.MessageQueueCreate(input)
+		queue, err := client.MessageQueueCreate(cmd.Context(), input)
 		if err != nil {
 			log.Fatalf("Failed to create message queue: %v", err)
 		}
@@ -122,6 +139,67 @@ var createMessageQueueCmd = &cobra.Command{
 	},
 }
 
+// messageQueueModifyInput builds the input of queue modify from its flags, an empty externalConnection
+// leaves the external connection unchanged.
+func messageQueueModifyInput(namespace string, name string, addIps []string, removeIps []string, externalConnection string, externalAllowedIps []string) (api.MessageQueueModifyInput, error) {
+	input := api.MessageQueueModifyInput{
+		Name:      name,
+		Namespace: namespace,
+	}
+
+	if len(addIps) > 0 || len(removeIps) > 0 {
+		input.AllowList = append(allowListToApi(addIps, api.StatePresent), allowListToApi(removeIps, api.StateAbsent)...)
+	}
+
+	switch externalConnection {
+	case "":
+	case "enable":
+		input.ExternalConnection = externalConnectionInput(true, externalAllowedIps)
+	case "disable":
+		input.ExternalConnection = externalConnectionInput(false, nil)
+	default:
+		return input, fmt.Errorf("invalid value %q for --external-connection, use enable or disable", externalConnection)
+	}
+
+	if input.AllowList == nil && input.ExternalConnection == nil {
+		return input, fmt.Errorf("nothing to modify, pass --add-ip, --remove-ip or --external-connection")
+	}
+	return input, nil
+}
+
+var modifyMessageQueueCmd = &cobra.Command{
+	Use:   "modify",
+	Short: "Modify the allowlist and external connection of a message queue",
+	Run: func(cmd *cobra.Command, args []string) {
+		namespace, _ := cmd.Flags().GetString("namespace")
+		name, _ := cmd.Flags().GetString("name")
+		addIps, _ := cmd.Flags().GetStringArray("add-ip")
+		removeIps, _ := cmd.Flags().GetStringArray("remove-ip")
+		externalConnection, _ := cmd.Flags().GetString("external-connection")
+		externalAllowedIps, _ := cmd.Flags().GetStringArray("external-allowed-ip")
+
+		input, err := messageQueueModifyInput(namespace, name, addIps, removeIps, externalConnection, externalAllowedIps)
+		if err != nil {
+			log.Fatalf("Failed to modify message queue: %v", err)
+		}
+
+		client := api.NewClient()
+		queue, err := client.MessageQueueModify(cmd.Context(), input)
+		if err != nil {
+			log.Fatalf("Failed to modify message queue: %v", err)
+		}
+
+		log.Println("Modified message queue:", queue.Name)
+		printMessageQueueIngress(queue)
+
+		if queue.ExternalConnection != nil && len(queue.ExternalConnection.Ports) > 0 {
+			fmt.Printf("External connection reachable at:\n")
+			fmt.Printf("Ipv4: %s:%d \n", queue.ExternalConnection.Ipv4, queue.ExternalConnection.Ports[0].ExternalPort)
+			fmt.Printf("Ipv6: %s:%d \n", queue.ExternalConnection.Ipv6, queue.ExternalConnection.Ports[0].ExternalPort)
+		}
+	},
+}
+
 var deleteMessageQueueCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete a message queue",
@@ -136,7 +214,9 @@ var deleteMessageQueueCmd = &cobra.Command{
 			Namespace: namespace,
 		}
 
-		result, err := client.MessageQueueDelete(input)
+		confirmDeletion(cmd, "message queue", name)
+
+		result, err := client.MessageQueueDelete(cmd.Context(), input)
 		if err != nil {
 			log.Fatalf("Failed to delete message queue: %v", err)
 		}
@@ -153,22 +233,34 @@ var listMessageQueuePlansCmd = &cobra.Command{
 	Short: "List all available message queue plans",
 	Run: func(cmd *cobra.Command, args []string) {
 		client := api.NewClient()
-		plans, err := client.MessageQueuePlans()
+		plans, err := client.MessageQueuePlans(cmd.Context())
 		if err != nil {
 			log.Fatalf("Failed to list message queue plans: %v", err)
 		}
 
-		if len(plans) == 0 {
+		choices := filterPlans(queuePlans(plans), planRequirementsFromFlags(cmd))
+		rankPlans(choices)
+
+		if printOutput(cmd, choices) {
+			return
+		}
+
+		if len(choices) == 0 {
 			fmt.Println("No message queue plans found.")
 			return
 		}
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.Debug)
-		fmt.Fprintln(w, "ID\t NAME\t GROUP\t CPU\t MEMORY (GB)\t REPLICAS\t STORAGE (GB)\t")
+		fmt.Fprintln(w, "ID\t NAME\t GROUP\t CPU\t MEMORY (GB)\t REPLICAS\t STORAGE (GB)\t CONNECTIONS\t MESSAGES/S\t PRICE\t")
 
-		for _, plan := range plans {
-			fmt.Fprintf(w, "%s\t %s\t %s\t %.2f\t %.2f\t %d\t %.2f\t\n",
-				plan.Id, plan.Name, plan.Group, plan.Cpu, plan.Memory, plan.Replicas, plan.Storage)
+		for _, plan := range choices {
+			connections, rate := "-", "-"
+			if plan.MaximumRate > 0 {
+				connections = fmt.Sprintf("%d", plan.Connections)
+				rate = fmt.Sprintf("%d-%d", plan.MinimumRate, plan.MaximumRate)
+			}
+			fmt.Fprintf(w, "%s\t %s\t %s\t %.2f\t %.2f\t %d\t %.2f\t %s\t %s\t %s\t\n",
+				plan.Id, plan.Name, plan.Group, plan.Cpu, plan.Memory, plan.Replicas, plan.Storage, connections, rate, formatPlanPrice(plan))
 		}
 
 		w.Flush()
@@ -180,11 +272,15 @@ var listMessageQueueVersionsCmd = &cobra.Command{
 	Short: "List all available message queue versions",
 	Run: func(cmd *cobra.Command, args []string) {
 		client := api.NewClient()
-		versions, err := client.MessageQueueVersions()
+		versions, err := client.MessageQueueVersions(cmd.Context())
 		if err != nil {
 			log.Fatalf("Failed to list message queue versions: %v", err)
 		}
 
+		if printOutput(cmd, versions) {
+			return
+		}
+
 		if len(versions) == 0 {
 			fmt.Println("No message queue versions found.")
 			return
@@ -215,7 +311,7 @@ var listAdminUserCredentialsCmd = &cobra.Command{
 			Namespace: namespace,
 		}
 
-		credentials, err := client.MessageQueueAdminCredentials(input, username)
+		credentials, err := client.MessageQueueAdminCredentials(cmd.Context(), input, username)
 		if err != nil {
 			log.Fatalf("Failed to get admin user credentials: %v", err)
 		}
@@ -231,6 +327,7 @@ var listAdminUserCredentialsCmd = &cobra.Command{
 
 func init() {
 	// List command
+	addOutputFlag(listMessageQueuesCmd)
 	messageQueueCmd.AddCommand(listMessageQueuesCmd)
 
 	// Get command
@@ -238,33 +335,50 @@ func init() {
 	getMessageQueueCmd.Flags().String("name", "", "Name of the message queue")
 	getMessageQueueCmd.MarkFlagRequired("namespace")
 	getMessageQueueCmd.MarkFlagRequired("name")
+	addOutputFlag(getMessageQueueCmd)
 	messageQueueCmd.AddCommand(getMessageQueueCmd)
 
 	// Create command
 	createMessageQueueCmd.Flags().StringP("namespace", "n", "", "Namespace")
 	createMessageQueueCmd.Flags().String("name", "", "Name for the message queue")
-	createMessageQueueCmd.Flags().String("plan", "", "Plan ID for the message queue")
+	createMessageQueueCmd.Flags().String("plan", "", "Plan ID or name for the message queue, or use the requirement flags to pick the cheapest plan that fits")
+	addQueueRequirementFlags(createMessageQueueCmd)
 	createMessageQueueCmd.Flags().String("type", "", "Type of the message queue (e.g., RabbitMQ)")
 	createMessageQueueCmd.Flags().String("version", "", "Version of the message queue")
 	createMessageQueueCmd.Flags().String("allowlist", "", "Comma-separated list of IP addresses or CIDR ranges (e.g., 192.168.1.1,10.0.0.0/24)")
 	createMessageQueueCmd.MarkFlagRequired("namespace")
 	createMessageQueueCmd.MarkFlagRequired("name")
-	createMessageQueueCmd.MarkFlagRequired("plan")
+	markPlanFlagsOneRequired(createMessageQueueCmd)
 	createMessageQueueCmd.MarkFlagRequired("type")
 	createMessageQueueCmd.MarkFlagRequired("version")
 	messageQueueCmd.AddCommand(createMessageQueueCmd)
 
+	// Modify command
+	modifyMessageQueueCmd.Flags().StringP("namespace", "n", "", "Namespace")
+	modifyMessageQueueCmd.Flags().String("name", "", "Name of the message queue")
+	modifyMessageQueueCmd.Flags().StringArray("add-ip", []string{}, "IP address or CIDR range to add to the allowlist (repeatable)")
+	modifyMessageQueueCmd.Flags().StringArray("remove-ip", []string{}, "IP address or CIDR range to remove from the allowlist (repeatable)")
+	modifyMessageQueueCmd.Flags().String("external-connection", "", "Enable or disable the external connection (enable or disable)")
+	modifyMessageQueueCmd.Flags().StringArray("external-allowed-ip", []string{"0.0.0.0/0", "::/0"}, "Allowed ip for the external connection")
+	modifyMessageQueueCmd.MarkFlagRequired("namespace")
+	modifyMessageQueueCmd.MarkFlagRequired("name")
+	messageQueueCmd.AddCommand(modifyMessageQueueCmd)
+
 	// Delete command
 	deleteMessageQueueCmd.Flags().StringP("namespace", "n", "", "Namespace")
 	deleteMessageQueueCmd.Flags().String("name", "", "Name of the message queue")
+	addConfirmFlag(deleteMessageQueueCmd)
 	deleteMessageQueueCmd.MarkFlagRequired("namespace")
 	deleteMessageQueueCmd.MarkFlagRequired("name")
 	messageQueueCmd.AddCommand(deleteMessageQueueCmd)
 
 	// Plans command
+	addQueueRequirementFlags(listMessageQueuePlansCmd)
+	addOutputFlag(listMessageQueuePlansCmd)
 	messageQueueCmd.AddCommand(listMessageQueuePlansCmd)
 
 	// Versions command
+	addOutputFlag(listMessageQueueVersionsCmd)
 	messageQueueCmd.AddCommand(listMessageQueueVersionsCmd)
 
 	// Admin credentials command
@@ -277,4 +391,6 @@ func init() {
 	messageQueueCmd.AddCommand(listAdminUserCredentialsCmd)
 
 	messageQueueCmd.AddCommand(messageQueueEnableExternalConnectionCmd)
+	messageQueueCmd.AddCommand(messageQueueAllowListCmd)
+	messageQueueCmd.AddCommand(messageQueueUserCmd)
 }
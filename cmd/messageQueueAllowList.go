@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+	"github.com/spf13/cobra"
+)
+
+var messageQueueAllowListCmd = &cobra.Command{
+	Use:   "allowlist",
+	Short: "Manage the allowlist of a message queue",
+}
+
+func allowListToApi(ips []string, state api.State) []api.AllowListInput {
+	allowList := make([]api.AllowListInput, 0, len(ips))
+	for _, ip := range ips {
+		allowList = append(allowList, api.AllowListInput{Ip: ip, State: state})
+	}
+	return allowList
+}
+
+// messageQueueAllowListInput returns the input that adds the IPs to the allowlist of a message queue, or
+// removes them when state is absent.
+func messageQueueAllowListInput(namespace string, name string, ips []string, state api.State) api.MessageQueueModifyInput {
+	return api.MessageQueueModifyInput{
+		Name:      name,
+		Namespace: namespace,
+		AllowList: allowListToApi(ips, state),
+	}
+}
+
+func printMessageQueueIngress(queue api.MessageQueueResult) {
+	writeMessageQueueIngress(os.Stdout, queue)
+}
+
+func writeMessageQueueIngress(out io.Writer, queue api.MessageQueueResult) {
+	writer := tabwriter.NewWriter(out, 0, 0, 3, ' ', tabwriter.Debug)
+	fmt.Fprintln(writer, "DOMAIN\t STATUS\t TLS\t ALLOWLIST\t")
+	fmt.Fprintf(
+		writer,
+		"%s\t %s\t %s\t %s\t\n",
+		queue.Ingress.DomainName,
+		queue.Ingress.Status,
+		enabledApiToString(queue.Ingress.TlsEnabled),
+		strings.Join(queue.Ingress.AllowList, ","),
+	)
+	writer.Flush()
+}
+
+func modifyMessageQueueAllowList(cmd *cobra.Command, state api.State) {
+	namespace, _ := cmd.Flags().GetString("namespace")
+	name, _ := cmd.Flags().GetString("name")
+	ips, _ := cmd.Flags().GetStringArray("ip")
+
+	input := messageQueueAllowListInput(namespace, name, ips, state)
+
+	client := api.NewClient()
+	queue, err := client.MessageQueueModify(cmd.Context(), input)
+	if err != nil {
+		log.Fatalf("Failed to modify allowlist of message queue %q/%q: %v", namespace, name, err)
+	}
+
+	printMessageQueueIngress(queue)
+}
+
+var addMessageQueueAllowListCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add IP addresses or CIDR ranges to the allowlist of a message queue",
+	Run: func(cmd *cobra.Command, args []string) {
+		modifyMessageQueueAllowList(cmd, api.StatePresent)
+	},
+}
+
+var removeMessageQueueAllowListCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Remove IP addresses or CIDR ranges from the allowlist of a message queue",
+	Run: func(cmd *cobra.Command, args []string) {
+		modifyMessageQueueAllowList(cmd, api.StateAbsent)
+	},
+}
+
+var listMessageQueueAllowListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the allowlist of a message queue",
+	Run: func(cmd *cobra.Command, args []string) {
+		namespace, _ := cmd.Flags().GetString("namespace")
+		name, _ := cmd.Flags().GetString("name")
+
+		client := api.NewClient()
+		queue, err := client.MessageQueueGet(cmd.Context(), api.MessageQueueResourceInput{
+			Name:      name,
+			Namespace: namespace,
+		})
+		if err != nil {
+			log.Fatalf("Failed to get message queue: %v", err)
+		}
+
+		if printOutput(cmd, queue.Ingress) {
+			return
+		}
+
+		printMessageQueueIngress(queue)
+	},
+}
+
+func init() {
+	addMessageQueueAllowListCmd.Flags().StringP("namespace", "n", "", "Namespace")
+	addMessageQueueAllowListCmd.Flags().String("name", "", "Name of the message queue")
+	addMessageQueueAllowListCmd.Flags().StringArray("ip", []string{}, "IP address or CIDR range to add (repeatable)")
+	addMessageQueueAllowListCmd.MarkFlagRequired("namespace")
+	addMessageQueueAllowListCmd.MarkFlagRequired("name")
+	addMessageQueueAllowListCmd.MarkFlagRequired("ip")
+	messageQueueAllowListCmd.AddCommand(addMessageQueueAllowListCmd)
+
+	removeMessageQueueAllowListCmd.Flags().StringP("namespace", "n", "", "Namespace")
+	removeMessageQueueAllowListCmd.Flags().String("name", "", "Name of the message queue")
+	removeMessageQueueAllowListCmd.Flags().StringArray("ip", []string{}, "IP address or CIDR range to remove (repeatable)")
+	removeMessageQueueAllowListCmd.MarkFlagRequired("namespace")
+	removeMessageQueueAllowListCmd.MarkFlagRequired("name")
+	removeMessageQueueAllowListCmd.MarkFlagRequired("ip")
+	messageQueueAllowListCmd.AddCommand(removeMessageQueueAllowListCmd)
+
+	listMessageQueueAllowListCmd.Flags().StringP("namespace", "n", "", "Namespace")
+	listMessageQueueAllowListCmd.Flags().String("name", "", "Name of the message queue")
+	listMessageQueueAllowListCmd.MarkFlagRequired("namespace")
+	listMessageQueueAllowListCmd.MarkFlagRequired("name")
+	addOutputFlag(listMessageQueueAllowListCmd)
+	messageQueueAllowListCmd.AddCommand(listMessageQueueAllowListCmd)
+}
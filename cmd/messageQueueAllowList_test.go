@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+func TestMessageQueueAllowListInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		ips      []string
+		state    api.State
+		expected []api.AllowListInput
+	}{
+		{
+			name:  "add",
+			ips:   []string{"192.0.2.10", "10.0.0.0/24"},
+			state: api.StatePresent,
+			expected: []api.AllowListInput{
+				{Ip: "192.0.2.10", State: api.StatePresent},
+				{Ip: "10.0.0.0/24", State: api.StatePresent},
+			},
+		},
+		{
+			name:     "remove",
+			ips:      []string{"::/0"},
+			state:    api.StateAbsent,
+			expected: []api.AllowListInput{{Ip: "::/0", State: api.StateAbsent}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := messageQueueAllowListInput("production", "events", tt.ips, tt.state)
+			if input.Namespace != "production" || input.Name != "events" {
+				t.Errorf("messageQueueAllowListInput() targets %q/%q, want production/events", input.Namespace, input.Name)
+			}
+			if input.ExternalConnection != nil {
+				t.Errorf("messageQueueAllowListInput() changes the external connection: %+v", input.ExternalConnection)
+			}
+			if !reflect.DeepEqual(input.AllowList, tt.expected) {
+				t.Errorf("messageQueueAllowListInput() allowlist = %+v, want %+v", input.AllowList, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWriteMessageQueueIngress(t *testing.T) {
+	t.Parallel()
+
+	queue := api.MessageQueueResult{
+		Ingress: api.MessageQueueResultIngressMessageQueueIngress{
+			MessageQueueIngressResult: api.MessageQueueIngressResult{
+				AllowList:  []string{"192.0.2.10", "10.0.0.0/24"},
+				DomainName: "mq.example.com",
+				Status:     api.StatusCreated,
+				TlsEnabled: true,
+			},
+		},
+	}
+
+	var out bytes.Buffer
+	writeMessageQueueIngress(&out, queue)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("writeMessageQueueIngress() wrote %d lines, want 2:\n%s", len(lines), out.String())
+	}
+	for _, expected := range []string{"DOMAIN", "STATUS", "TLS", "ALLOWLIST"} {
+		if !strings.Contains(lines[0], expected) {
+			t.Errorf("header %q does not contain %q", lines[0], expected)
+		}
+	}
+	for _, expected := range []string{"mq.example.com", "CREATED", "True", "192.0.2.10,10.0.0.0/24"} {
+		if !strings.Contains(lines[1], expected) {
+			t.Errorf("row %q does not contain %q", lines[1], expected)
+		}
+	}
+}
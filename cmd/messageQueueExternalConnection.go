@@ -13,6 +13,28 @@ var messageQueueEnableExternalConnectionCmd = &cobra.Command{
 	Short: "Enable or Disable external connection on a cloud database cluster",
 }
 
+// externalConnectionInput returns the input that enables the external connection of a message queue for
+// the allowed IPs, or disables it.
+func externalConnectionInput(enable bool, allowedIps []string) *api.ExternalConnectionInput {
+	if !enable {
+		return &api.ExternalConnectionInput{
+			State: api.StateAbsent,
+			Ports: []api.ExternalConnectionPortInput{},
+		}
+	}
+	return &api.ExternalConnectionInput{
+		State:    api.StatePresent,
+		SharedIp: true,
+		Ports: []api.ExternalConnectionPortInput{
+			{
+				AllowList: allowListToApi(allowedIps, api.StatePresent),
+				Protocol:  api.ProtocolTcp,
+				State:     api.StatePresent,
+			},
+		},
+	}
+}
+
 var enableMessageQueueExternalConnectionCmd = &cobra.Command{
 	Use:   "enable",
 	Short: "Enable external connection on a cloud database cluster",
@@ -21,29 +43,15 @@ var enableMessageQueueExternalConnectionCmd = &cobra.Command{
 		clusterName, _ := cmd.Flags().GetString("cluster")
 		allowedIp, _ := cmd.Flags().GetStringArray("allowed-ip")
 
-		allowList := make([]api.AllowListInput, 0)
-		for _, ip := range allowedIp {
-			allowList = append(allowList, api.AllowListInput{Ip: ip, State: api.StatePresent})
-		}
-
 		resource := api.MessageQueueModifyInput{
-			Name:      clusterName,
-			Namespace: namespace,
-			ExternalConnection: &api.ExternalConnectionInput{
-				State:    api.StatePresent,
-				SharedIp: true,
-				Ports: []api.ExternalConnectionPortInput{
-					{
-						AllowList: allowList,
-						State:     api.StatePresent,
-					},
-				},
-			},
+			Name:               clusterName,
+			Namespace:          namespace,
+			ExternalConnection: externalConnectionInput(true, allowedIp),
 		}
 
 		client := api.NewClient()
 
-		cluster, err := client.MessageQueueModify(resource)
+		cluster, err := client.MessageQueueModify(cmd.Context(), resource)
 		if err != nil {
 			log.Fatalf("Failed to enable external connection in cluster %q/%q: %v", namespace, clusterName, err)
 			return
@@ -62,17 +70,14 @@ var disableMessageQueueExternalConnectionCmd = &cobra.Command{
 		clusterName, _ := cmd.Flags().GetString("cluster")
 
 		resource := api.MessageQueueModifyInput{
-			Name:      clusterName,
-			Namespace: namespace,
-			ExternalConnection: &api.ExternalConnectionInput{
-				State: api.StateAbsent,
-				Ports: []api.ExternalConnectionPortInput{},
-			},
+			Name:               clusterName,
+			Namespace:          namespace,
+			ExternalConnection: externalConnectionInput(false, nil),
 		}
 
 		client := api.NewClient()
 
-		cluster, err := client.MessageQueueModify(resource)
+		cluster, err := client.MessageQueueModify(cmd.Context(), resource)
 		if err != nil {
 			log.Fatalf("Failed to disable external connection in cluster %q/%q: %v", namespace, clusterName, err)
 			return
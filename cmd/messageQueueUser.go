@@ -0,0 +1,243 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+	"github.com/spf13/cobra"
+)
+
+const (
+	amqpPort    = 5672
+	amqpTlsPort = 5671
+)
+
+type amqpDetails struct {
+	Host     string
+	Port     int
+	Tls      bool
+	User     string
+	Password string
+}
+
+func buildAmqpUrl(details amqpDetails) string {
+	scheme := "amqp"
+	if details.Tls {
+		scheme = "amqps"
+	}
+
+	dsn := url.URL{
+		Scheme: scheme,
+		User:   url.UserPassword(details.User, details.Password),
+		Host:   net.JoinHostPort(details.Host, strconv.Itoa(details.Port)),
+		Path:   "/",
+	}
+	return dsn.String()
+}
+
+func formatAmqpCredentials(format string, credentials api.MessageQueueUserCredentialsResult, details amqpDetails) (string, error) {
+	switch format {
+	case "dsn":
+		return credentials.Dsn, nil
+	case "url":
+		return buildAmqpUrl(details), nil
+	case "env":
+		return fmt.Sprintf(
+			"AMQP_URL=%s\nAMQP_HOST=%s\nAMQP_PORT=%s\nAMQP_USER=%s\nAMQP_PASSWORD=%s",
+			shellQuote(buildAmqpUrl(details)),
+			shellQuote(details.Host),
+			shellQuote(strconv.Itoa(details.Port)),
+			shellQuote(details.User),
+			shellQuote(details.Password),
+		), nil
+	case "json":
+		data, err := json.MarshalIndent(map[string]any{
+			"url":      buildAmqpUrl(details),
+			"host":     details.Host,
+			"port":     details.Port,
+			"tls":      details.Tls,
+			"user":     details.User,
+			"password": details.Password,
+		}, "", "  ")
+		return string(data), err
+	}
+
+	return "", fmt.Errorf("unknown format %q, use one of: dsn, url, env, json", format)
+}
+
+var messageQueueUserCmd = &cobra.Command{
+	Use:   "user",
+	Short: "Manage users of a message queue",
+}
+
+var listMessageQueueUsersCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the users of a message queue",
+	Run: func(cmd *cobra.Command, args []string) {
+		namespace, _ := cmd.Flags().GetString("namespace")
+		name, _ := cmd.Flags().GetString("name")
+
+		client := api.NewClient()
+		queue, err := client.MessageQueueGet(cmd.Context(), api.MessageQueueResourceInput{
+			Name:      name,
+			Namespace: namespace,
+		})
+		if err != nil {
+			log.Fatalf("Failed to get message queue: %v", err)
+		}
+
+		var users []api.MessageQueueResultAdminUserMessageQueueUser
+		if queue.AdminUser != nil {
+			users = append(users, *queue.AdminUser)
+		}
+		if printOutput(cmd, users) {
+			return
+		}
+
+		if len(users) == 0 {
+			fmt.Println("No users found.")
+			return
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.Debug)
+		fmt.Fprintln(w, "NAME\t ROLE\t STATUS\t")
+		fmt.Fprintf(w, "%s\t %s\t %s\t\n", queue.AdminUser.Name, queue.AdminUser.Role, queue.AdminUser.Status)
+		w.Flush()
+	},
+}
+
+var modifyMessageQueueUserCmd = &cobra.Command{
+	Use:   "modify",
+	Short: "Change the password of a message queue user",
+	Run: func(cmd *cobra.Command, args []string) {
+		namespace, _ := cmd.Flags().GetString("namespace")
+		name, _ := cmd.Flags().GetString("name")
+		userName, _ := cmd.Flags().GetString("user")
+		password, _ := cmd.Flags().GetString("password")
+		generate, _ := cmd.Flags().GetBool("generate-password")
+
+		if generate {
+			var err error
+			password, err = generatePassword(32)
+			if err != nil {
+				log.Fatalf("Failed to generate password: %v", err)
+			}
+		}
+
+		if password == "" {
+			log.Fatalf("Pass either --password or --generate-password")
+		}
+
+		client := api.NewClient()
+		user, err := client.MessageQueueUserModify(cmd.Context(), api.CloudDatabaseClusterUserModifyInput{
+			Cluster: &api.CloudDatabaseClusterResourceInput{
+				Name:      name,
+				Namespace: namespace,
+			},
+			User: &api.DatabaseUserInput{
+				Name:        userName,
+				Password:    &password,
+				State:       api.StatePresent,
+				Permissions: []api.DatabaseUserPermissionInput{},
+			},
+		})
+		if err != nil {
+			log.Fatalf("Failed to modify user %q in message queue %q/%q: %v", userName, namespace, name, err)
+		}
+
+		fmt.Printf("User %q updated.\n", user.Name)
+		if generate {
+			fmt.Printf("Password: %s\n", password)
+		}
+	},
+}
+
+var messageQueueUserCredentialsCmd = &cobra.Command{
+	Use:   "credentials",
+	Short: "Print the credentials of a message queue user as an AMQP connection string",
+	Run: func(cmd *cobra.Command, args []string) {
+		namespace, _ := cmd.Flags().GetString("namespace")
+		name, _ := cmd.Flags().GetString("name")
+		userName, _ := cmd.Flags().GetString("user")
+		format, _ := cmd.Flags().GetString("format")
+		external, _ := cmd.Flags().GetBool("external")
+
+		input := api.MessageQueueResourceInput{
+			Name:      name,
+			Namespace: namespace,
+		}
+
+		client := api.NewClient()
+		queue, err := client.MessageQueueGet(cmd.Context(), input)
+		if err != nil {
+			log.Fatalf("Failed to get message queue: %v", err)
+		}
+
+		credentials, err := client.MessageQueueAdminCredentials(cmd.Context(), input, userName)
+		if err != nil {
+			log.Fatalf("Failed to get user credentials: %v", err)
+		}
+
+		details := amqpDetails{
+			Host:     queue.Ingress.DomainName,
+			Port:     amqpPort,
+			Tls:      queue.Ingress.TlsEnabled,
+			User:     credentials.Name,
+			Password: credentials.Password,
+		}
+		if details.Tls {
+			details.Port = amqpTlsPort
+		}
+
+		if external {
+			if queue.ExternalConnection == nil || len(queue.ExternalConnection.Ports) == 0 {
+				log.Fatalf("External connection is not enabled on message queue %q/%q", namespace, name)
+			}
+			details.Host = queue.ExternalConnection.Ipv4
+			details.Port = queue.ExternalConnection.Ports[0].ExternalPort
+			details.Tls = false
+		}
+
+		output, err := formatAmqpCredentials(format, credentials, details)
+		if err != nil {
+			log.Fatalf("Failed to format credentials: %v", err)
+		}
+		fmt.Println(output)
+	},
+}
+
+func init() {
+	listMessageQueueUsersCmd.Flags().StringP("namespace", "n", "", "Namespace")
+	listMessageQueueUsersCmd.Flags().String("name", "", "Name of the message queue")
+	listMessageQueueUsersCmd.MarkFlagRequired("namespace")
+	listMessageQueueUsersCmd.MarkFlagRequired("name")
+	addOutputFlag(listMessageQueueUsersCmd)
+	messageQueueUserCmd.AddCommand(listMessageQueueUsersCmd)
+
+	modifyMessageQueueUserCmd.Flags().StringP("namespace", "n", "", "Namespace")
+	modifyMessageQueueUserCmd.Flags().String("name", "", "Name of the message queue")
+	modifyMessageQueueUserCmd.Flags().String("user", "", "User name")
+	modifyMessageQueueUserCmd.Flags().String("password", "", "New password for the user")
+	modifyMessageQueueUserCmd.Flags().Bool("generate-password", false, "Generate a random password and print it")
+	modifyMessageQueueUserCmd.MarkFlagRequired("namespace")
+	modifyMessageQueueUserCmd.MarkFlagRequired("name")
+	modifyMessageQueueUserCmd.MarkFlagRequired("user")
+	messageQueueUserCmd.AddCommand(modifyMessageQueueUserCmd)
+
+	messageQueueUserCredentialsCmd.Flags().StringP("namespace", "n", "", "Namespace")
+	messageQueueUserCredentialsCmd.Flags().String("name", "", "Name of the message queue")
+	messageQueueUserCredentialsCmd.Flags().String("user", "", "User name")
+	messageQueueUserCredentialsCmd.Flags().String("format", "url", "Output format (dsn, url, env or json)")
+	messageQueueUserCredentialsCmd.Flags().Bool("external", false, "Use the external connection instead of the ingress domain")
+	messageQueueUserCredentialsCmd.MarkFlagRequired("namespace")
+	messageQueueUserCredentialsCmd.MarkFlagRequired("name")
+	messageQueueUserCredentialsCmd.MarkFlagRequired("user")
+	messageQueueUserCmd.AddCommand(messageQueueUserCredentialsCmd)
+}
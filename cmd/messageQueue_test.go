@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+func TestMessageQueueModifyInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		addIps             []string
+		removeIps          []string
+		externalConnection string
+		externalAllowedIps []string
+		allowList          []api.AllowListInput
+		external           *api.ExternalConnectionInput
+		wantErr            bool
+	}{
+		{
+			name:      "add and remove ips",
+			addIps:    []string{"192.0.2.10"},
+			removeIps: []string{"10.0.0.0/24"},
+			allowList: []api.AllowListInput{
+				{Ip: "192.0.2.10", State: api.StatePresent},
+				{Ip: "10.0.0.0/24", State: api.StateAbsent},
+			},
+		},
+		{
+			name:               "enable external connection",
+			externalConnection: "enable",
+			externalAllowedIps: []string{"0.0.0.0/0"},
+			external:           externalConnectionInput(true, []string{"0.0.0.0/0"}),
+		},
+		{
+			name:               "disable external connection",
+			externalConnection: "disable",
+			external:           &api.ExternalConnectionInput{State: api.StateAbsent, Ports: []api.ExternalConnectionPortInput{}},
+		},
+		{
+			name:               "allowlist and external connection together",
+			removeIps:          []string{"192.0.2.10"},
+			externalConnection: "disable",
+			allowList:          []api.AllowListInput{{Ip: "192.0.2.10", State: api.StateAbsent}},
+			external:           &api.ExternalConnectionInput{State: api.StateAbsent, Ports: []api.ExternalConnectionPortInput{}},
+		},
+		{
+			name:               "unknown external connection",
+			externalConnection: "on",
+			wantErr:            true,
+		},
+		{
+			name:    "nothing to modify",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			input, err := messageQueueModifyInput("production", "events", tt.addIps, tt.removeIps, tt.externalConnection, tt.externalAllowedIps)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("messageQueueModifyInput() expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("messageQueueModifyInput() unexpected error: %v", err)
+			}
+			if input.Namespace != "production" || input.Name != "events" {
+				t.Errorf("messageQueueModifyInput() targets %q/%q, want production/events", input.Namespace, input.Name)
+			}
+			if !reflect.DeepEqual(input.AllowList, tt.allowList) {
+				t.Errorf("messageQueueModifyInput() allowlist = %+v, want %+v", input.AllowList, tt.allowList)
+			}
+			if !reflect.DeepEqual(input.ExternalConnection, tt.external) {
+				t.Errorf("messageQueueModifyInput() external connection = %+v, want %+v", input.ExternalConnection, tt.external)
+			}
+		})
+	}
+}
+
+func TestExternalConnectionInput(t *testing.T) {
+	t.Parallel()
+
+	enabled := externalConnectionInput(true, []string{"0.0.0.0/0", "::/0"})
+	expected := &api.ExternalConnectionInput{
+		State:    api.StatePresent,
+		SharedIp: true,
+		Ports: []api.ExternalConnectionPortInput{
+			{
+				AllowList: []api.AllowListInput{
+					{Ip: "0.0.0.0/0", State: api.StatePresent},
+					{Ip: "::/0", State: api.StatePresent},
+				},
+				Protocol: api.ProtocolTcp,
+				State:    api.StatePresent,
+			},
+		},
+	}
+	if !reflect.DeepEqual(enabled, expected) {
+		t.Errorf("externalConnectionInput(true) = %+v, want %+v", enabled, expected)
+	}
+
+	disabled := externalConnectionInput(false, []string{"0.0.0.0/0"})
+	if disabled.State != api.StateAbsent || len(disabled.Ports) != 0 {
+		t.Errorf("externalConnectionInput(false) = %+v, want an absent connection without ports", disabled)
+	}
+}
@@ -1,10 +1,12 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"text/tabwriter"
+	"time"
 
 	"github.com/nexaa-cloud/nexaa-cli/api"
 
@@ -22,12 +24,16 @@ var listNamespacesCmd = &cobra.Command{
 	Short: "List all namespaces",
 	Run: func(cmd *cobra.Command, args []string) {
 		client := api.NewClient()
-		namespaces, err := client.NamespacesList()
+		namespaces, err := client.NamespacesList(cmd.Context())
 
 		if err != nil {
 			log.Fatalf("Failed to list namespaces: %v", err)
 		}
 
+		if printOutput(cmd, namespaces) {
+			return
+		}
+
 		if len(namespaces) == 0 {
 			fmt.Println("No namespaces found.")
 			return
@@ -59,7 +65,7 @@ var createNamespaceCmd = &cobra.Command{
 
 		client := api.NewClient()
 
-		namespace, err := client.NamespaceCreate(input)
+		namespace, err := client.NamespaceCreate(cmd.Context(), input)
 		if err != nil {
 			log.Fatalf("Failed to create namespace: %v", err)
 		}
@@ -68,15 +74,100 @@ var createNamespaceCmd = &cobra.Command{
 	},
 }
 
+// deleteNamespaceResources deletes everything in the namespace, volumes and registries last
+// because they can only be removed once no container or job uses them anymore.
+func deleteNamespaceResources(ctx context.Context, client *api.Client, namespace api.NamespaceResult, timeout time.Duration) {
+	name := namespace.Name
+
+	for _, container := range namespace.Containers {
+		if _, err := client.ContainerDelete(ctx, name, container.Name); err != nil {
+			log.Fatalf("Failed to delete container %q: %v", container.Name, err)
+		}
+		fmt.Printf("Deleted container %q.\n", container.Name)
+	}
+	for _, job := range namespace.ContainerJobs {
+		if _, err := client.ContainerJobDelete(ctx, name, job.Name); err != nil {
+			log.Fatalf("Failed to delete container job %q: %v", job.Name, err)
+		}
+		fmt.Printf("Deleted container job %q.\n", job.Name)
+	}
+	for _, queue := range namespace.MessageQueues {
+		if _, err := client.MessageQueueDelete(ctx, api.MessageQueueResourceInput{Namespace: name, Name: queue.Name}); err != nil {
+			log.Fatalf("Failed to delete message queue %q: %v", queue.Name, err)
+		}
+		fmt.Printf("Deleted message queue %q.\n", queue.Name)
+	}
+	for _, cluster := range namespace.CloudDatabaseClusters {
+		if _, err := client.CloudDatabaseClusterDelete(ctx, api.CloudDatabaseClusterResourceInput{Namespace: name, Name: cluster.Name}); err != nil {
+			log.Fatalf("Failed to delete cloud database cluster %q: %v", cluster.Name, err)
+		}
+		fmt.Printf("Deleted cloud database cluster %q.\n", cluster.Name)
+	}
+
+	fmt.Println("Waiting for containers and jobs to be removed...")
+	err := waitUntil(timeout, func() (bool, error) {
+		current, err := client.NamespaceListByName(ctx, name)
+		if err != nil {
+			return false, err
+		}
+		return len(current.Containers) == 0 && len(current.ContainerJobs) == 0, nil
+	})
+	if err != nil {
+		log.Fatalf("Containers and jobs in namespace %q were not removed: %v", name, err)
+	}
+
+	for _, volume := range namespace.Volumes {
+		if _, err := client.VolumeDelete(ctx, name, volume.Name); err != nil {
+			log.Fatalf("Failed to delete volume %q: %v", volume.Name, err)
+		}
+		fmt.Printf("Deleted volume %q.\n", volume.Name)
+	}
+	for _, registry := range namespace.PrivateRegistries {
+		if _, err := client.RegistryDelete(ctx, name, registry.Name); err != nil {
+			log.Fatalf("Failed to delete registry %q: %v", registry.Name, err)
+		}
+		fmt.Printf("Deleted registry %q.\n", registry.Name)
+	}
+
+	fmt.Println("Waiting for the namespace to be empty...")
+	err = waitUntil(timeout, func() (bool, error) {
+		current, err := client.NamespaceListByName(ctx, name)
+		if err != nil {
+			return false, err
+		}
+		return len(namespaceDependents(current)) == 0, nil
+	})
+	if err != nil {
+		log.Fatalf("Namespace %q is not empty: %v", name, err)
+	}
+}
+
 var deleteNamespaceCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete a namespace",
 	Run: func(cmd *cobra.Command, args []string) {
 		name, _ := cmd.Flags().GetString("name")
+		cascade, _ := cmd.Flags().GetBool("cascade")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
 
 		client := api.NewClient()
 
-		_, err := client.NamespaceDelete(name)
+		namespace, err := client.NamespaceListByName(cmd.Context(), name)
+		if err != nil {
+			log.Fatalf("Failed to get namespace: %v", err)
+		}
+
+		if !cascade {
+			refuseWithDependents("namespace", name, namespaceDependents(namespace), true)
+		}
+
+		confirmDeletion(cmd, "namespace", name)
+
+		if cascade {
+			deleteNamespaceResources(cmd.Context(), client, namespace, timeout)
+		}
+
+		_, err = client.NamespaceDelete(cmd.Context(), name)
 		if err != nil {
 			log.Fatalf("Failed to delete namespace: %v", err)
 		}
@@ -86,6 +177,7 @@ var deleteNamespaceCmd = &cobra.Command{
 }
 
 func init() {
+	addOutputFlag(listNamespacesCmd)
 	namespaceCmd.AddCommand(listNamespacesCmd)
 
 	createNamespaceCmd.Flags().StringP("name", "n", "", "Name")
@@ -94,6 +186,9 @@ func init() {
 	namespaceCmd.AddCommand(createNamespaceCmd)
 
 	deleteNamespaceCmd.Flags().StringP("name", "n", "", "Name")
+	deleteNamespaceCmd.Flags().Bool("cascade", false, "Delete all resources in the namespace before deleting it")
+	deleteNamespaceCmd.Flags().Duration("timeout", 10*time.Minute, "Maximum time to wait for resources to be removed when using --cascade")
+	addConfirmFlag(deleteNamespaceCmd)
 	deleteNamespaceCmd.MarkFlagRequired("name")
 	namespaceCmd.AddCommand(deleteNamespaceCmd)
 }
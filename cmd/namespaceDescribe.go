@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+	"github.com/spf13/cobra"
+)
+
+// resourceStatus is a single line of the `namespace describe` overview.
+type resourceStatus struct {
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	State   string `json:"state"`
+	Locked  bool   `json:"locked"`
+	Failing bool   `json:"failing"`
+	Details string `json:"details,omitempty"`
+}
+
+type namespaceSummary struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	State       string           `json:"state"`
+	Resources   []resourceStatus `json:"resources"`
+	Total       int              `json:"total"`
+	Failing     int              `json:"failing"`
+	Locked      int              `json:"locked"`
+}
+
+// isFailingState reports whether a state or run status indicates that something went wrong.
+func isFailingState(state string) bool {
+	state = strings.ToUpper(state)
+	return strings.Contains(state, "FAIL") || strings.Contains(state, "ERROR")
+}
+
+// lastJobRun returns the most recently started run of a container job.
+func lastJobRun(runs []api.NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun) *api.NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun {
+	var last *api.NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun
+	for i, run := range runs {
+		if run.StartTime == nil {
+			continue
+		}
+		if last == nil || run.StartTime.After(*last.StartTime) {
+			last = &runs[i]
+		}
+	}
+	return last
+}
+
+// summariseNamespace flattens all resources of a namespace into status lines and counts the
+// failing and locked ones. Containers with missing replicas count as failing once they are settled.
+func summariseNamespace(namespace api.NamespaceDescription) namespaceSummary {
+	summary := namespaceSummary{
+		Name:        namespace.Name,
+		Description: namespace.Description,
+		State:       namespace.State,
+		Resources:   []resourceStatus{},
+	}
+
+	add := func(status resourceStatus) {
+		status.Failing = status.Failing || isFailingState(status.State)
+		summary.Resources = append(summary.Resources, status)
+	}
+
+	for _, container := range namespace.Containers {
+		add(resourceStatus{
+			Kind:    "container",
+			Name:    container.Name,
+			State:   container.State,
+			Locked:  container.Locked,
+			Failing: container.AvailableReplicas < container.NumberOfReplicas && !isTransitionalState(container.State),
+			Details: fmt.Sprintf("%d/%d replicas", container.AvailableReplicas, container.NumberOfReplicas),
+		})
+	}
+	for _, job := range namespace.ContainerJobs {
+		details := "never ran"
+		failing := false
+		if run := lastJobRun(job.Runs); run != nil {
+			details = fmt.Sprintf("last run %s at %s", strings.ToLower(run.Status), run.StartTime.Local().Format("2006-01-02 15:04"))
+			failing = isFailingState(run.Status)
+		}
+		if !job.Enabled {
+			details += ", disabled"
+		}
+		add(resourceStatus{
+			Kind:    "job",
+			Name:    job.Name,
+			State:   job.State,
+			Locked:  job.Locked,
+			Failing: failing,
+			Details: details,
+		})
+	}
+	for _, volume := range namespace.Volumes {
+		details := fmt.Sprintf("%s of %s", formatGigabytes(volume.Usage), formatGigabytes(volume.Size))
+		if volume.Size > 0 {
+			details += fmt.Sprintf(" (%.0f%%)", volume.Usage/volume.Size*100)
+		}
+		add(resourceStatus{
+			Kind:    "volume",
+			Name:    volume.Name,
+			State:   volume.State,
+			Locked:  volume.Locked,
+			Details: details,
+		})
+	}
+	for _, cluster := range namespace.CloudDatabaseClusters {
+		add(resourceStatus{Kind: "database-cluster", Name: cluster.Name, State: cluster.State, Locked: cluster.Locked})
+	}
+	for _, queue := range namespace.MessageQueues {
+		add(resourceStatus{Kind: "message-queue", Name: queue.Name, State: queue.State, Locked: queue.Locked})
+	}
+	for _, registry := range namespace.PrivateRegistries {
+		add(resourceStatus{Kind: "registry", Name: registry.Name, State: registry.State, Locked: registry.Locked})
+	}
+
+	for _, resource := range summary.Resources {
+		summary.Total++
+		if resource.Failing {
+			summary.Failing++
+		}
+		if resource.Locked {
+			summary.Locked++
+		}
+	}
+
+	return summary
+}
+
+func printNamespaceSummary(summary namespaceSummary) {
+	fmt.Printf("Namespace: %s (%s)\n", summary.Name, summary.State)
+	if summary.Description != "" {
+		fmt.Printf("Description: %s\n", summary.Description)
+	}
+	fmt.Println()
+
+	if len(summary.Resources) > 0 {
+		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.Debug)
+		fmt.Fprintln(writer, "KIND\t NAME\t STATE\t LOCKED\t DETAILS\t")
+		for _, resource := range summary.Resources {
+			state := resource.State
+			if resource.Failing {
+				state += " (!)"
+			}
+			fmt.Fprintf(writer, "%s\t %s\t %s\t %s\t %s\t\n", resource.Kind, resource.Name, state, enabledApiToString(resource.Locked), resource.Details)
+		}
+		writer.Flush()
+		fmt.Println()
+	}
+
+	fmt.Printf("Summary: %d resources, %d failing, %d locked\n", summary.Total, summary.Failing, summary.Locked)
+}
+
+var describeNamespaceCmd = &cobra.Command{
+	Use:   "describe NAME",
+	Short: "Show the status of all resources in a namespace",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := api.NewClient()
+		namespace, err := client.NamespaceDescribe(cmd.Context(), args[0])
+		if err != nil {
+			log.Fatalf("Failed to describe namespace: %v", err)
+		}
+
+		summary := summariseNamespace(namespace)
+
+		if printOutput(cmd, summary) {
+			return
+		}
+
+		printNamespaceSummary(summary)
+	},
+}
+
+func init() {
+	addOutputFlag(describeNamespaceCmd)
+	namespaceCmd.AddCommand(describeNamespaceCmd)
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+func TestLastJobRun(t *testing.T) {
+	t.Parallel()
+
+	older := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+
+	runs := []api.NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun{
+		{Name: "pending", Status: "PENDING"},
+		{Name: "newer", Status: "FAILED", StartTime: &newer},
+		{Name: "older", Status: "SUCCEEDED", StartTime: &older},
+	}
+
+	if run := lastJobRun(runs); run == nil || run.Name != "newer" {
+		t.Errorf("lastJobRun() = %+v, expected the newer run", run)
+	}
+	if run := lastJobRun(nil); run != nil {
+		t.Errorf("lastJobRun(nil) = %+v, expected nil", run)
+	}
+}
+
+func TestSummariseNamespace(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		namespace api.NamespaceDescription
+		failing   []string
+		locked    int
+	}{
+		{
+			name: "healthy namespace",
+			namespace: api.NamespaceDescription{
+				Containers: []api.NamespaceDescriptionContainersContainer{{Name: "web", State: "RUNNING", AvailableReplicas: 2, NumberOfReplicas: 2}},
+				Volumes:    []api.NamespaceDescriptionVolumesVolume{{Name: "data", State: "ACTIVE", Size: 10, Usage: 2}},
+			},
+		},
+		{
+			name: "missing replicas",
+			namespace: api.NamespaceDescription{
+				Containers: []api.NamespaceDescriptionContainersContainer{
+					{Name: "web", State: "RUNNING", AvailableReplicas: 1, NumberOfReplicas: 2},
+					{Name: "api", State: "UPDATING", AvailableReplicas: 0, NumberOfReplicas: 2},
+				},
+			},
+			failing: []string{"web"},
+		},
+		{
+			name: "failed job run and failing state",
+			namespace: api.NamespaceDescription{
+				ContainerJobs: []api.NamespaceDescriptionContainerJobsContainerJob{
+					{Name: "backup", State: "ACTIVE", Runs: []api.NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun{{Status: "FAILED", StartTime: &start}}},
+				},
+				CloudDatabaseClusters: []api.NamespaceDescriptionCloudDatabaseClustersCloudDatabaseCluster{{Name: "db", State: "CREATE_FAILED"}},
+			},
+			failing: []string{"backup", "db"},
+		},
+		{
+			name: "locked resources",
+			namespace: api.NamespaceDescription{
+				MessageQueues:     []api.NamespaceDescriptionMessageQueuesMessageQueue{{Name: "queue", State: "ACTIVE", Locked: true}},
+				PrivateRegistries: []api.NamespaceDescriptionPrivateRegistriesPrivateRegistry{{Name: "gitlab", State: "ACTIVE", Locked: true}},
+			},
+			locked: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			summary := summariseNamespace(tt.namespace)
+
+			var failing []string
+			for _, resource := range summary.Resources {
+				if resource.Failing {
+					failing = append(failing, resource.Name)
+				}
+			}
+
+			if !slices.Equal(failing, tt.failing) || summary.Failing != len(tt.failing) {
+				t.Errorf("summariseNamespace() failing = %v (count %d), expected %v", failing, summary.Failing, tt.failing)
+			}
+			if summary.Locked != tt.locked {
+				t.Errorf("summariseNamespace() locked = %d, expected %d", summary.Locked, tt.locked)
+			}
+			if summary.Total != len(summary.Resources) {
+				t.Errorf("summariseNamespace() total = %d, expected %d", summary.Total, len(summary.Resources))
+			}
+		})
+	}
+}
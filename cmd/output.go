@@ -0,0 +1,300 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"github.com/spf13/cobra"
+)
+
+const outputUsage = "Output format: table, json, go-template=TEMPLATE, jsonpath=TEMPLATE or custom-columns=HEADER:PATH,..."
+
+// addOutputFlag adds --output to a get or list command, printOutput handles the formats other than table.
+func addOutputFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP("output", "o", "table", outputUsage)
+}
+
+// jsonPathPart is literal text, or a path between braces in a jsonpath template.
+type jsonPathPart struct {
+	text string
+	path string
+}
+
+type outputColumn struct {
+	header string
+	path   string
+}
+
+// outputFormat is a parsed --output value other than table.
+type outputFormat struct {
+	kind     string
+	template *template.Template
+	jsonPath []jsonPathPart
+	columns  []outputColumn
+}
+
+func parseOutputFormat(output string) (outputFormat, error) {
+	kind, argument, _ := strings.Cut(output, "=")
+	format := outputFormat{kind: kind}
+
+	switch kind {
+	case "json":
+		return format, nil
+	case "go-template":
+		if argument == "" {
+			return format, fmt.Errorf("go-template needs a template, e.g. -o go-template='{{.Name}}'")
+		}
+		var err error
+		format.template, err = template.New("output").Option("missingkey=error").Parse(argument)
+		return format, err
+	case "jsonpath":
+		var err error
+		format.jsonPath, err = parseJsonPath(argument)
+		return format, err
+	case "custom-columns":
+		var err error
+		format.columns, err = parseColumns(argument)
+		return format, err
+	}
+	return format, fmt.Errorf("unknown output %q, use table, json, go-template=TEMPLATE, jsonpath=TEMPLATE or custom-columns=HEADER:PATH,...", output)
+}
+
+// jsonPathExpression converts a kubectl style path, such as .ports[*].name, to the path syntax of selectPath.
+func jsonPathExpression(path string) string {
+	path = strings.ReplaceAll(path, "[*]", "[]")
+	if !strings.HasPrefix(path, ".") {
+		path = "." + path
+	}
+	return path
+}
+
+// parseJsonPath parses a template such as '{.name} {.externalConnection.ipv4}{"\n"}'.
+func parseJsonPath(text string) ([]jsonPathPart, error) {
+	if text == "" {
+		return nil, fmt.Errorf("jsonpath needs a template, e.g. -o jsonpath='{.name}'")
+	}
+
+	var parts []jsonPathPart
+	for text != "" {
+		start := strings.Index(text, "{")
+		if start < 0 {
+			parts = append(parts, jsonPathPart{text: text})
+			break
+		}
+		if start > 0 {
+			parts = append(parts, jsonPathPart{text: text[:start]})
+		}
+
+		end := strings.Index(text[start:], "}")
+		if end < 0 {
+			return nil, fmt.Errorf("missing '}' in jsonpath %q", text)
+		}
+		expression := strings.TrimSpace(text[start+1 : start+end])
+		text = text[start+end+1:]
+
+		if strings.HasPrefix(expression, `"`) {
+			literal, err := strconv.Unquote(expression)
+			if err != nil {
+				return nil, fmt.Errorf("invalid string %s in jsonpath", expression)
+			}
+			parts = append(parts, jsonPathPart{text: literal})
+			continue
+		}
+		parts = append(parts, jsonPathPart{path: jsonPathExpression(expression)})
+	}
+	return parts, nil
+}
+
+// parseColumns parses HEADER:PATH pairs separated by commas.
+func parseColumns(spec string) ([]outputColumn, error) {
+	if spec == "" {
+		return nil, fmt.Errorf("custom-columns needs columns, e.g. -o custom-columns=NAME:.name,STATE:.state")
+	}
+
+	var columns []outputColumn
+	for _, column := range strings.Split(spec, ",") {
+		header, path, ok := strings.Cut(column, ":")
+		if !ok || header == "" || path == "" {
+			return nil, fmt.Errorf("invalid column %q, use HEADER:PATH", column)
+		}
+		columns = append(columns, outputColumn{header: header, path: jsonPathExpression(path)})
+	}
+	return columns, nil
+}
+
+// outputItems returns the elements of a list, or the value itself for a single resource.
+func outputItems(value any) []any {
+	reflected := reflect.ValueOf(value)
+	if reflected.Kind() != reflect.Slice {
+		return []any{value}
+	}
+
+	items := make([]any, reflected.Len())
+	for i := range items {
+		items[i] = reflected.Index(i).Interface()
+	}
+	return items
+}
+
+// marshalResult encodes a result struct, or a list of them, with the names used by the API. The value is
+// marshalled through a pointer, the flattened fragments of genqlient only marshal that way.
+func marshalResult(value any, indent bool) ([]byte, error) {
+	reflected := reflect.ValueOf(value)
+	if reflected.Kind() == reflect.Slice && reflected.IsNil() {
+		return []byte("[]"), nil
+	}
+
+	pointer := reflect.New(reflected.Type())
+	pointer.Elem().Set(reflected)
+	if indent {
+		return json.MarshalIndent(pointer.Interface(), "", "  ")
+	}
+	return json.Marshal(pointer.Interface())
+}
+
+// jsonValue converts a result struct to the decoded form of its JSON.
+func jsonValue(item any) (any, error) {
+	data, err := marshalResult(item, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	err = decoder.Decode(&decoded)
+	return decoded, err
+}
+
+// formatJsonValues joins selected values with spaces, strings as they are and other values as JSON.
+func formatJsonValues(values []any) string {
+	formatted := make([]string, 0, len(values))
+	for _, value := range values {
+		switch typed := value.(type) {
+		case string:
+			formatted = append(formatted, typed)
+		case json.Number:
+			formatted = append(formatted, typed.String())
+		default:
+			data, _ := json.Marshal(typed)
+			formatted = append(formatted, string(data))
+		}
+	}
+	return strings.Join(formatted, " ")
+}
+
+// write prints value, a result struct or a list of them. Templates and jsonpath are applied to each
+// element of a list and end with a newline, so every resource is printed on its own line.
+func (format outputFormat) write(out io.Writer, value any) error {
+	if format.kind == "json" {
+		data, err := marshalResult(value, true)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(data))
+		return nil
+	}
+
+	var writer *tabwriter.Writer
+	if format.kind == "custom-columns" {
+		writer = tabwriter.NewWriter(out, 0, 0, 3, ' ', tabwriter.Debug)
+		headers := make([]string, len(format.columns))
+		for i, column := range format.columns {
+			headers[i] = column.header
+		}
+		fmt.Fprintln(writer, strings.Join(headers, "\t ")+"\t")
+	}
+
+	for _, item := range outputItems(value) {
+		var line string
+		switch format.kind {
+		case "go-template":
+			var buffer bytes.Buffer
+			if err := format.template.Execute(&buffer, item); err != nil {
+				return err
+			}
+			line = buffer.String()
+		case "jsonpath", "custom-columns":
+			decoded, err := jsonValue(item)
+			if err != nil {
+				return err
+			}
+			if line, err = format.selectLine(decoded); err != nil {
+				return err
+			}
+		}
+
+		if writer != nil {
+			fmt.Fprintln(writer, line)
+			continue
+		}
+		fmt.Fprint(out, line)
+		if !strings.HasSuffix(line, "\n") {
+			fmt.Fprintln(out)
+		}
+	}
+
+	if writer != nil {
+		return writer.Flush()
+	}
+	return nil
+}
+
+// selectLine renders the jsonpath template or the custom columns for a single decoded item.
+func (format outputFormat) selectLine(decoded any) (string, error) {
+	var line strings.Builder
+	if format.kind == "jsonpath" {
+		for _, part := range format.jsonPath {
+			if part.path == "" {
+				line.WriteString(part.text)
+				continue
+			}
+			values, err := selectPath(decoded, part.path)
+			if err != nil {
+				return "", err
+			}
+			line.WriteString(formatJsonValues(values))
+		}
+		return line.String(), nil
+	}
+
+	for _, column := range format.columns {
+		values, err := selectPath(decoded, column.path)
+		if err != nil {
+			return "", err
+		}
+		cell := formatJsonValues(values)
+		if cell == "" || cell == "null" {
+			cell = "<none>"
+		}
+		line.WriteString(cell + "\t ")
+	}
+	return strings.TrimSuffix(line.String(), " "), nil
+}
+
+// printOutput prints value in the --output format and reports whether it did. It returns false for
+// table, the command then prints its own table.
+func printOutput(cmd *cobra.Command, value any) bool {
+	output, _ := cmd.Flags().GetString("output")
+	if output == "" || output == "table" {
+		return false
+	}
+
+	format, err := parseOutputFormat(output)
+	if err != nil {
+		log.Fatalf("Invalid --output: %v", err)
+	}
+	if err := format.write(os.Stdout, value); err != nil {
+		log.Fatalf("Failed to write output: %v", err)
+	}
+	return true
+}
@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+func TestParseOutputFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		output string
+		kind   string
+		err    bool
+	}{
+		{output: "json", kind: "json"},
+		{output: "go-template={{.Name}}", kind: "go-template"},
+		{output: "jsonpath={.name}", kind: "jsonpath"},
+		{output: "custom-columns=NAME:.name", kind: "custom-columns"},
+		{output: "yaml", err: true},
+		{output: "go-template=", err: true},
+		{output: "go-template={{.Name", err: true},
+		{output: "jsonpath={.name", err: true},
+		{output: `jsonpath={"\x"}`, err: true},
+		{output: "custom-columns=", err: true},
+		{output: "custom-columns=NAME", err: true},
+		{output: "custom-columns=NAME:.name,:.state", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.output, func(t *testing.T) {
+			t.Parallel()
+
+			format, err := parseOutputFormat(test.output)
+			if (err != nil) != test.err {
+				t.Fatalf("parseOutputFormat(%q) error = %v, expected error %v", test.output, err, test.err)
+			}
+			if !test.err && format.kind != test.kind {
+				t.Errorf("parseOutputFormat(%q) kind = %q, expected %q", test.output, format.kind, test.kind)
+			}
+		})
+	}
+}
+
+func TestOutputFormatWrite(t *testing.T) {
+	t.Parallel()
+
+	web := api.ContainerResult{
+		Name:  "web",
+		State: "created",
+		ExternalConnection: &api.ContainerResultExternalConnection{
+			ExternalConnectionResult: api.ExternalConnectionResult{
+				Ipv4: "192.0.2.1",
+				Ports: []api.ExternalConnectionResultPortsExternalConnectionPort{
+					{ExternalPort: 8080},
+					{ExternalPort: 8443},
+				},
+			},
+		},
+	}
+	worker := api.ContainerResult{Name: "worker", State: "running"}
+
+	tests := []struct {
+		name     string
+		output   string
+		value    any
+		expected string
+	}{
+		{
+			name:     "go-template on a single resource",
+			output:   "go-template={{.Name}}",
+			value:    web,
+			expected: "web\n",
+		},
+		{
+			name:     "go-template reads fields of a fragment",
+			output:   "go-template={{.Name}} {{.ExternalConnection.Ipv4}}",
+			value:    web,
+			expected: "web 192.0.2.1\n",
+		},
+		{
+			name:     "go-template on a list",
+			output:   "go-template={{.Name}}={{.State}}",
+			value:    []api.ContainerResult{web, worker},
+			expected: "web=created\nworker=running\n",
+		},
+		{
+			name:     "jsonpath selects a field of a fragment",
+			output:   "jsonpath={.externalConnection.ipv4}",
+			value:    web,
+			expected: "192.0.2.1\n",
+		},
+		{
+			name:     "jsonpath with literals and every element",
+			output:   `jsonpath={.name}:{"\t"}{.externalConnection.ports[*].externalPort}{"\n"}`,
+			value:    web,
+			expected: "web:\t8080 8443\n",
+		},
+		{
+			name:     "jsonpath on a list",
+			output:   "jsonpath={.name}",
+			value:    []api.ContainerResult{web, worker},
+			expected: "web\nworker\n",
+		},
+		{
+			name:     "custom-columns",
+			output:   "custom-columns=NAME:.name,IPV4:externalConnection.ipv4",
+			value:    []api.ContainerResult{web, worker},
+			expected: "NAME     | IPV4        |\nweb      | 192.0.2.1   |\nworker   | <none>      |\n",
+		},
+		{
+			name:     "custom-columns on plans use the API field names",
+			output:   "custom-columns=ID:.id,CPU:.cpu",
+			value:    []plan{{Id: "small", Cpu: 0.5}},
+			expected: "ID      | CPU   |\nsmall   | 0.5   |\n",
+		},
+		{
+			name:     "jsonpath on resources",
+			output:   "jsonpath={.resources} {.ram}",
+			value:    []resourceOption{{Resources: api.ContainerResourcesCpu250Ram500, Ram: 0.5}},
+			expected: "CPU_250_RAM_500 0.5\n",
+		},
+		{
+			name:     "json of an empty list",
+			output:   "json",
+			value:    []api.ContainerResult(nil),
+			expected: "[]\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			format, err := parseOutputFormat(test.output)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var out bytes.Buffer
+			if err := format.write(&out, test.value); err != nil {
+				t.Fatalf("write() error = %v", err)
+			}
+			if out.String() != test.expected {
+				t.Errorf("write() = %q, expected %q", out.String(), test.expected)
+			}
+		})
+	}
+}
+
+func TestOutputFormatWriteErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		output string
+	}{
+		{name: "unknown template field", output: "go-template={{.Missing}}"},
+		{name: "field of a string", output: "jsonpath={.name.first}"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			format, err := parseOutputFormat(test.output)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := format.write(&bytes.Buffer{}, api.ContainerResult{Name: "web"}); err == nil {
+				t.Errorf("write() with %q returned no error", test.output)
+			}
+		})
+	}
+}
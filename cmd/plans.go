@@ -0,0 +1,227 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+	"github.com/spf13/cobra"
+)
+
+// plan is a message queue or database cluster plan with the fields used to choose one.
+// Connections and MaximumRate are zero when the plan has no benchmark.
+type plan struct {
+	Id          string  `json:"id"`
+	Name        string  `json:"name"`
+	Group       string  `json:"group"`
+	Cpu         float64 `json:"cpu"`
+	Memory      float64 `json:"memory"`
+	Storage     float64 `json:"storage"`
+	Replicas    int     `json:"replicas"`
+	Price       *int    `json:"price"`
+	Currency    string  `json:"currency"`
+	Connections int     `json:"connections"`
+	MinimumRate int     `json:"minimumRate"`
+	MaximumRate int     `json:"maximumRate"`
+}
+
+// planRequirements are the minimum values a plan must offer, zero means no requirement.
+type planRequirements struct {
+	cpu         float64
+	memory      float64
+	storage     float64
+	rate        int
+	connections int
+}
+
+func queuePlans(plans []api.MessageQueuePlanResult) []plan {
+	result := make([]plan, 0, len(plans))
+	for _, queuePlan := range plans {
+		choice := plan{
+			Id:       queuePlan.Id,
+			Name:     queuePlan.Name,
+			Group:    queuePlan.Group,
+			Cpu:      queuePlan.Cpu,
+			Memory:   queuePlan.Memory,
+			Storage:  queuePlan.Storage,
+			Replicas: queuePlan.Replicas,
+			Price:    queuePlan.Price.Amount,
+		}
+		if queuePlan.Price.Currency != nil {
+			choice.Currency = *queuePlan.Price.Currency
+		}
+		if queuePlan.Benchmark != nil {
+			choice.Connections = queuePlan.Benchmark.Connections
+			choice.MinimumRate = queuePlan.Benchmark.MinimumMessageRate
+			choice.MaximumRate = queuePlan.Benchmark.MaximumMessageRate
+		}
+		result = append(result, choice)
+	}
+	return result
+}
+
+func databasePlans(plans []api.CloudDatabaseClusterPlan) []plan {
+	result := make([]plan, 0, len(plans))
+	for _, databasePlan := range plans {
+		choice := plan{
+			Id:      databasePlan.Id,
+			Name:    databasePlan.Name,
+			Group:   databasePlan.Group,
+			Cpu:     float64(databasePlan.Cpu),
+			Memory:  databasePlan.Memory,
+			Storage: float64(databasePlan.Storage),
+			Price:   databasePlan.Price.Amount,
+		}
+		if databasePlan.Price.Currency != nil {
+			choice.Currency = *databasePlan.Price.Currency
+		}
+		result = append(result, choice)
+	}
+	return result
+}
+
+// filterPlans keeps the plans that meet the requirements. Plans without a benchmark never meet
+// a message rate or connection requirement.
+func filterPlans(plans []plan, requirements planRequirements) []plan {
+	var filtered []plan
+	for _, choice := range plans {
+		if choice.Cpu < requirements.cpu || choice.Memory < requirements.memory || choice.Storage < requirements.storage {
+			continue
+		}
+		if choice.MaximumRate < requirements.rate || choice.Connections < requirements.connections {
+			continue
+		}
+		filtered = append(filtered, choice)
+	}
+	return filtered
+}
+
+// rankPlans orders plans by price, unknown prices last, then by CPU, memory and storage.
+func rankPlans(plans []plan) {
+	sort.SliceStable(plans, func(i, j int) bool {
+		a, b := plans[i], plans[j]
+		switch {
+		case a.Price != nil && b.Price == nil:
+			return true
+		case a.Price == nil && b.Price != nil:
+			return false
+		case a.Price != nil && *a.Price != *b.Price:
+			return *a.Price < *b.Price
+		case a.Cpu != b.Cpu:
+			return a.Cpu < b.Cpu
+		case a.Memory != b.Memory:
+			return a.Memory < b.Memory
+		}
+		return a.Storage < b.Storage
+	})
+}
+
+// validPlans lists the plans for an error message.
+func validPlans(plans []plan) string {
+	options := make([]string, len(plans))
+	for i, choice := range plans {
+		options[i] = fmt.Sprintf("%s (%s)", choice.Name, choice.Id)
+	}
+	return strings.Join(options, ", ")
+}
+
+// resolvePlan finds a plan by ID or by name, ignoring case.
+func resolvePlan(reference string, plans []plan) (plan, error) {
+	var matches []plan
+	for _, choice := range plans {
+		if choice.Id == reference {
+			return choice, nil
+		}
+		if strings.EqualFold(choice.Name, reference) {
+			matches = append(matches, choice)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return plan{}, fmt.Errorf("unknown plan %q, valid plans are: %s", reference, validPlans(plans))
+	case 1:
+		return matches[0], nil
+	}
+	return plan{}, fmt.Errorf("plan name %q is ambiguous, use one of the IDs: %s", reference, validPlans(matches))
+}
+
+// choosePlan resolves the plan reference, or picks the cheapest plan that meets the requirements.
+func choosePlan(reference string, requirements planRequirements, plans []plan) (plan, error) {
+	if reference != "" {
+		return resolvePlan(reference, plans)
+	}
+
+	candidates := filterPlans(plans, requirements)
+	if len(candidates) == 0 {
+		return plan{}, fmt.Errorf("no plan meets the requirements, valid plans are: %s", validPlans(plans))
+	}
+
+	rankPlans(candidates)
+	return candidates[0], nil
+}
+
+func formatPlanPrice(choice plan) string {
+	if choice.Price == nil {
+		return "unknown"
+	}
+	return formatPrice(*choice.Price, choice.Currency)
+}
+
+// addPlanRequirementFlags adds the --min-cpu, --min-memory and --min-storage flags shared by plan listing and creation.
+func addPlanRequirementFlags(cmd *cobra.Command) {
+	cmd.Flags().Float64("min-cpu", 0, "Minimum number of CPU cores of the plan")
+	cmd.Flags().Float64("min-memory", 0, "Minimum memory of the plan in GB")
+	cmd.Flags().Float64("min-storage", 0, "Minimum storage of the plan in GB")
+}
+
+func planRequirementsFromFlags(cmd *cobra.Command) planRequirements {
+	var requirements planRequirements
+	requirements.cpu, _ = cmd.Flags().GetFloat64("min-cpu")
+	requirements.memory, _ = cmd.Flags().GetFloat64("min-memory")
+	requirements.storage, _ = cmd.Flags().GetFloat64("min-storage")
+	if cmd.Flags().Lookup("min-rate") != nil {
+		requirements.rate, _ = cmd.Flags().GetInt("min-rate")
+		requirements.connections, _ = cmd.Flags().GetInt("connections")
+	}
+	return requirements
+}
+
+// addQueueRequirementFlags adds the benchmark requirements for message queue plans.
+func addQueueRequirementFlags(cmd *cobra.Command) {
+	addPlanRequirementFlags(cmd)
+	cmd.Flags().Int("min-rate", 0, "Minimum message rate per second the plan must handle according to its benchmark")
+	cmd.Flags().Int("connections", 0, "Minimum number of connections the plan must handle according to its benchmark")
+}
+
+// markPlanFlagsOneRequired requires either --plan or at least one of the requirement flags.
+func markPlanFlagsOneRequired(cmd *cobra.Command) {
+	names := []string{"plan"}
+	for _, name := range []string{"min-cpu", "min-memory", "min-storage", "min-rate", "connections"} {
+		if cmd.Flags().Lookup(name) != nil {
+			names = append(names, name)
+		}
+	}
+	cmd.MarkFlagsOneRequired(names...)
+	for _, name := range names[1:] {
+		cmd.MarkFlagsMutuallyExclusive("plan", name)
+	}
+}
+
+// planFromFlags returns the ID of the plan given by --plan, or of the cheapest plan that meets the requirement flags.
+func planFromFlags(cmd *cobra.Command, plans []plan) string {
+	reference, _ := cmd.Flags().GetString("plan")
+
+	choice, err := choosePlan(reference, planRequirementsFromFlags(cmd), plans)
+	if err != nil {
+		log.Fatalf("Failed to pick plan: %v", err)
+	}
+	if reference == "" {
+		fmt.Fprintf(os.Stderr, "Using plan %s (%s, %s per month).\n", choice.Name, choice.Id, formatPlanPrice(choice))
+	}
+
+	return choice.Id
+}
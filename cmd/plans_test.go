@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+func testPlans() []plan {
+	amount := func(value int) *int { return &value }
+
+	return []plan{
+		{Id: "q-small", Name: "Small", Cpu: 1, Memory: 2, Storage: 10, Price: amount(2500), Connections: 100, MaximumRate: 4000},
+		{Id: "q-medium", Name: "Medium", Cpu: 2, Memory: 4, Storage: 20, Price: amount(5000), Connections: 250, MaximumRate: 8000},
+		{Id: "q-large", Name: "Large", Cpu: 4, Memory: 8, Storage: 40, Price: amount(9000), Connections: 500, MaximumRate: 20000},
+		{Id: "q-custom", Name: "Custom", Cpu: 2, Memory: 4, Storage: 20},
+	}
+}
+
+func planIds(plans []plan) []string {
+	ids := make([]string, len(plans))
+	for i, choice := range plans {
+		ids[i] = choice.Id
+	}
+	return ids
+}
+
+func TestQueuePlansReadsBenchmark(t *testing.T) {
+	t.Parallel()
+
+	currency := "EUR"
+	plans := queuePlans([]api.MessageQueuePlanResult{
+		{Id: "a", Price: api.MessageQueuePlanResultPrice{Currency: &currency}, Benchmark: &api.MessageQueuePlanResultBenchmarkMessageQueuePlanBenchmark{Connections: 200, MinimumMessageRate: 1000, MaximumMessageRate: 5000}},
+		{Id: "b"},
+	})
+
+	if plans[0].Connections != 200 || plans[0].MinimumRate != 1000 || plans[0].MaximumRate != 5000 || plans[0].Currency != "EUR" {
+		t.Errorf("queuePlans() = %+v, expected the benchmark and currency to be copied", plans[0])
+	}
+	if plans[1].Connections != 0 || plans[1].MaximumRate != 0 {
+		t.Errorf("queuePlans() = %+v, expected no benchmark", plans[1])
+	}
+}
+
+func TestFilterPlans(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		requirements planRequirements
+		expected     []string
+	}{
+		{name: "no requirements", requirements: planRequirements{}, expected: []string{"q-small", "q-medium", "q-large", "q-custom"}},
+		{name: "memory", requirements: planRequirements{memory: 4}, expected: []string{"q-medium", "q-large", "q-custom"}},
+		{name: "cpu and storage", requirements: planRequirements{cpu: 3, storage: 30}, expected: []string{"q-large"}},
+		{name: "rate and connections", requirements: planRequirements{rate: 5000, connections: 200}, expected: []string{"q-medium", "q-large"}},
+		{name: "unbenchmarked plans never meet a rate", requirements: planRequirements{rate: 1}, expected: []string{"q-small", "q-medium", "q-large"}},
+		{name: "nothing fits", requirements: planRequirements{connections: 1000}, expected: nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := planIds(filterPlans(testPlans(), test.requirements))
+			if !slices.Equal(got, test.expected) {
+				t.Errorf("filterPlans() = %v, expected %v", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestRankPlans(t *testing.T) {
+	t.Parallel()
+
+	plans := testPlans()
+	slices.Reverse(plans)
+	rankPlans(plans)
+
+	expected := []string{"q-small", "q-medium", "q-large", "q-custom"}
+	if got := planIds(plans); !slices.Equal(got, expected) {
+		t.Errorf("rankPlans() = %v, expected %v", got, expected)
+	}
+}
+
+func TestChoosePlan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		reference    string
+		requirements planRequirements
+		expected     string
+		err          string
+	}{
+		{name: "by id", reference: "q-large", expected: "q-large"},
+		{name: "by name ignoring case", reference: "medium", expected: "q-medium"},
+		{name: "unknown lists valid plans", reference: "huge", err: "valid plans are: Small (q-small), Medium (q-medium)"},
+		{name: "cheapest that fits", requirements: planRequirements{rate: 5000, connections: 200}, expected: "q-medium"},
+		{name: "reference wins over requirements", reference: "Small", requirements: planRequirements{cpu: 4}, expected: "q-small"},
+		{name: "nothing fits", requirements: planRequirements{cpu: 16}, err: "no plan meets the requirements"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := choosePlan(test.reference, test.requirements, testPlans())
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Errorf("choosePlan() error = %v, expected it to contain %q", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("choosePlan() unexpected error: %v", err)
+			}
+			if got.Id != test.expected {
+				t.Errorf("choosePlan() = %s, expected %s", got.Id, test.expected)
+			}
+		})
+	}
+}
+
+func TestResolvePlanAmbiguousName(t *testing.T) {
+	t.Parallel()
+
+	plans := []plan{{Id: "a", Name: "Standard"}, {Id: "b", Name: "standard"}}
+	if _, err := resolvePlan("STANDARD", plans); err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("resolvePlan() error = %v, expected an ambiguous name error", err)
+	}
+}
@@ -1,10 +1,14 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"regexp"
 	"text/tabwriter"
+	"time"
 
 	"github.com/nexaa-cloud/nexaa-cli/api"
 	"github.com/spf13/cobra"
@@ -22,12 +26,16 @@ var listRegistriesCmd = &cobra.Command{
 		namespace, _ := cmd.Flags().GetString("namespace")
 		client := api.NewClient()
 
-		registries, err := client.ListRegistries(namespace)
+		registries, err := client.ListRegistries(cmd.Context(), namespace)
 
 		if err != nil {
 			log.Fatalf("Failed to list registries: %v", err)
 		}
 
+		if printOutput(cmd, registries) {
+			return
+		}
+
 		if len(registries) == 0 {
 			fmt.Println("No registries found.")
 			return
@@ -52,8 +60,11 @@ var createRegistryCmd = &cobra.Command{
 		namespace, _ := cmd.Flags().GetString("namespace")
 		name, _ := cmd.Flags().GetString("name")
 		source, _ := cmd.Flags().GetString("source")
-		username, _ := cmd.Flags().GetString("username")
-		password, _ := cmd.Flags().GetString("password")
+		username, password := registryCredentialsFromFlags(cmd, source)
+
+		if username == "" || password == "" {
+			log.Fatalf("Pass --username and one of --password, --password-stdin or --from-docker-config")
+		}
 
 		input := api.RegistryCreateInput{
 			Namespace: namespace,
@@ -64,8 +75,15 @@ var createRegistryCmd = &cobra.Command{
 			Verify:    true,
 		}
 
+		skipVerify, _ := cmd.Flags().GetBool("skip-verify")
+		if !skipVerify {
+			if err := api.VerifyRegistryCredentials(registryVerifyClient, source, username, password); err != nil {
+				log.Fatalf("Failed to verify registry credentials: %v", err)
+			}
+		}
+
 		client := api.NewClient()
-		registry, err := client.RegistryCreate(input)
+		registry, err := client.RegistryCreate(cmd.Context(), input)
 
 		if err != nil {
 			log.Fatalf("Failed to create registry: %v", err)
@@ -75,6 +93,212 @@ var createRegistryCmd = &cobra.Command{
 	},
 }
 
+// registryVerifyClient is used to verify credentials against the registry, so an unreachable host fails
+// instead of hanging.
+var registryVerifyClient = &http.Client{Timeout: 15 * time.Second}
+
+// rotatedRegistrySuffix matches the timestamp that update-credentials appends to the name of a registry.
+var rotatedRegistrySuffix = regexp.MustCompile(`-\d{14}$`)
+
+// rotatedRegistryName returns the name of the registry replacing name, an earlier timestamp suffix is replaced.
+func rotatedRegistryName(name string, now time.Time) string {
+	return rotatedRegistrySuffix.ReplaceAllString(name, "") + "-" + now.Format("20060102150405")
+}
+
+// listRegistryUsers returns the containers and jobs in the namespace that use the registry.
+func listRegistryUsers(ctx context.Context, client *api.Client, namespace string, registry string) ([]api.ContainerResult, []api.ContainerJobResult) {
+	containers, err := client.ListContainers(ctx, namespace)
+	if err != nil {
+		log.Fatalf("Failed to list containers: %v", err)
+	}
+	jobs, err := client.ContainerJobList(ctx, namespace)
+	if err != nil {
+		log.Fatalf("Failed to list container jobs: %v", err)
+	}
+	return registryUsers(registry, containers, jobs)
+}
+
+var getRegistryCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Get details of a private registry",
+	Run: func(cmd *cobra.Command, args []string) {
+		namespace, _ := cmd.Flags().GetString("namespace")
+		name, _ := cmd.Flags().GetString("name")
+
+		client := api.NewClient()
+
+		registry, err := client.ListRegistryByName(cmd.Context(), namespace, name)
+		if err != nil {
+			log.Fatalf("Failed to get registry: %v", err)
+		}
+
+		if printOutput(cmd, registry) {
+			return
+		}
+
+		containers, jobs := listRegistryUsers(cmd.Context(), client, namespace, name)
+
+		fmt.Printf("Name:       %s\n", registry.Name)
+		fmt.Printf("Source:     %s\n", registry.Source)
+		fmt.Printf("Username:   %s\n", registry.Username)
+		fmt.Printf("State:      %s\n", registry.State)
+		fmt.Printf("Locked:     %t\n", registry.Locked)
+		fmt.Printf("Created at: %s\n", registry.CreatedAt)
+
+		if len(containers) == 0 && len(jobs) == 0 {
+			fmt.Println("Used by:    -")
+			return
+		}
+		fmt.Println("Used by:")
+		for _, container := range containers {
+			fmt.Printf("  container/%s\n", container.Name)
+		}
+		for _, job := range jobs {
+			fmt.Printf("  job/%s\n", job.Name)
+		}
+	},
+}
+
+var verifyRegistryCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify registry credentials with a Docker Registry v2 login from this machine",
+	Run: func(cmd *cobra.Command, args []string) {
+		namespace, _ := cmd.Flags().GetString("namespace")
+		name, _ := cmd.Flags().GetString("name")
+		source, _ := cmd.Flags().GetString("source")
+		username, _ := cmd.Flags().GetString("username")
+
+		if name != "" {
+			if namespace == "" {
+				log.Fatalf("--namespace is required when using --name")
+			}
+
+			registry, err := api.NewClient().ListRegistryByName(cmd.Context(), namespace, name)
+			if err != nil {
+				log.Fatalf("Failed to get registry: %v", err)
+			}
+			if source == "" {
+				source = registry.Source
+			}
+			if username == "" {
+				username = registry.Username
+			}
+		}
+
+		if source == "" {
+			log.Fatalf("Pass --source, or --name of an existing registry")
+		}
+
+		configUsername, password := registryCredentialsFromFlags(cmd, source)
+		if username == "" {
+			username = configUsername
+		}
+		if username == "" || password == "" {
+			log.Fatalf("Pass --username and one of --password, --password-stdin or --from-docker-config")
+		}
+
+		if err := api.VerifyRegistryCredentials(registryVerifyClient, source, username, password); err != nil {
+			log.Fatalf("Verification failed: %v", err)
+		}
+
+		fmt.Printf("Credentials of %q are valid for %s.\n", username, source)
+	},
+}
+
+var updateRegistryCredentialsCmd = &cobra.Command{
+	Use:   "update-credentials",
+	Short: "Replace the credentials of a private registry without breaking the containers using it",
+	Long: `Replace the credentials of a private registry without breaking the containers using it.
+
+Registries cannot be modified, so a new registry is created with the new credentials,
+every container and job using the old registry is pointed to the new one and the old
+registry is deleted afterwards.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		namespace, _ := cmd.Flags().GetString("namespace")
+		name, _ := cmd.Flags().GetString("name")
+		newName, _ := cmd.Flags().GetString("new-name")
+		skipVerify, _ := cmd.Flags().GetBool("skip-verify")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+
+		client := api.NewClient()
+
+		old, err := client.ListRegistryByName(cmd.Context(), namespace, name)
+		if err != nil {
+			log.Fatalf("Failed to get registry: %v", err)
+		}
+
+		username, password := registryCredentialsFromFlags(cmd, old.Source)
+		if password == "" {
+			log.Fatalf("Pass one of --password, --password-stdin or --from-docker-config")
+		}
+
+		if newName == "" {
+			newName = rotatedRegistryName(name, time.Now())
+		}
+		if username == "" {
+			username = old.Username
+		}
+
+		if !skipVerify {
+			if err := api.VerifyRegistryCredentials(registryVerifyClient, old.Source, username, password); err != nil {
+				log.Fatalf("Failed to verify registry credentials: %v", err)
+			}
+		}
+
+		containers, jobs := listRegistryUsers(cmd.Context(), client, namespace, name)
+
+		registry, err := client.RegistryCreate(cmd.Context(), api.RegistryCreateInput{
+			Namespace: namespace,
+			Name:      newName,
+			Source:    old.Source,
+			Username:  username,
+			Password:  password,
+			Verify:    true,
+		})
+		if err != nil {
+			log.Fatalf("Failed to create registry %q: %v", newName, err)
+		}
+		fmt.Printf("Created registry %q.\n", registry.Name)
+
+		var containerNames, jobNames []string
+		for _, container := range containers {
+			input := containerModifyInput(namespace, container)
+			input.Registry = &registry.Name
+			_, err := client.ContainerModify(cmd.Context(), input)
+			if err != nil {
+				log.Fatalf("Failed to point container %q to registry %q, registry %q was kept: %v", container.Name, registry.Name, name, err)
+			}
+			fmt.Printf("Container %q now uses registry %q.\n", container.Name, registry.Name)
+			containerNames = append(containerNames, container.Name)
+		}
+
+		for _, job := range jobs {
+			input := containerJobModifyInput(namespace, job)
+			input.Registry = &registry.Name
+			_, err := client.ContainerJobModify(cmd.Context(), input)
+			if err != nil {
+				log.Fatalf("Failed to point container job %q to registry %q, registry %q was kept: %v", job.Name, registry.Name, name, err)
+			}
+			fmt.Printf("Container job %q now uses registry %q.\n", job.Name, registry.Name)
+			jobNames = append(jobNames, job.Name)
+		}
+
+		// The old registry can only be removed once the rollout no longer pulls from it.
+		if len(containerNames) > 0 || len(jobNames) > 0 {
+			fmt.Println("Waiting for containers and jobs to switch registry...")
+			if err := waitForSettled(cmd.Context(), client, namespace, containerNames, jobNames, timeout); err != nil {
+				log.Fatalf("Containers and jobs did not switch to registry %q, registry %q was kept: %v", registry.Name, name, err)
+			}
+		}
+
+		result, err := client.RegistryDelete(cmd.Context(), namespace, name)
+		if err != nil || !result {
+			log.Fatalf("Failed to delete old registry %q: %v", name, err)
+		}
+		fmt.Printf("Deleted old registry %q.\n", name)
+	},
+}
+
 var deleteRegistryCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete a private registry",
@@ -84,7 +308,19 @@ var deleteRegistryCmd = &cobra.Command{
 
 		client := api.NewClient()
 
-		result, err := client.RegistryDelete(namespace, name)
+		containers, err := client.ListContainers(cmd.Context(), namespace)
+		if err != nil {
+			log.Fatalf("Failed to list containers: %v", err)
+		}
+		jobs, err := client.ContainerJobList(cmd.Context(), namespace)
+		if err != nil {
+			log.Fatalf("Failed to list container jobs: %v", err)
+		}
+		refuseWithDependents("registry", name, registryDependents(name, containers, jobs), false)
+
+		confirmDeletion(cmd, "registry", name)
+
+		result, err := client.RegistryDelete(cmd.Context(), namespace, name)
 		if err != nil {
 			log.Fatalf("Failed to delete registry: %q", err)
 			return
@@ -102,22 +338,51 @@ var deleteRegistryCmd = &cobra.Command{
 func init() {
 	listRegistriesCmd.Flags().StringP("namespace", "n", "", "Namespace")
 	listRegistriesCmd.MarkFlagRequired("namespace")
+	addOutputFlag(listRegistriesCmd)
 	registryCmd.AddCommand(listRegistriesCmd)
 
 	createRegistryCmd.Flags().StringP("namespace", "n", "", "Namespace")
 	createRegistryCmd.Flags().String("name", "", "Name for the private registry")
 	createRegistryCmd.Flags().String("source", "", "Source URL for the private registry")
-	createRegistryCmd.Flags().String("username", "", "Username for the private registry")
+	createRegistryCmd.Flags().String("username", "", "Username for the private registry (default: the username from the docker config)")
 	createRegistryCmd.Flags().String("password", "", "Password for the private registry")
+	createRegistryCmd.Flags().Bool("skip-verify", false, "Skip verifying the credentials from this machine")
 	createRegistryCmd.MarkFlagRequired("namespace")
 	createRegistryCmd.MarkFlagRequired("name")
 	createRegistryCmd.MarkFlagRequired("source")
-	createRegistryCmd.MarkFlagRequired("username")
-	createRegistryCmd.MarkFlagRequired("password")
+	addRegistryCredentialFlags(createRegistryCmd)
 	registryCmd.AddCommand(createRegistryCmd)
 
+	getRegistryCmd.Flags().StringP("namespace", "n", "", "Namespace")
+	getRegistryCmd.Flags().String("name", "", "Name of the private registry")
+	getRegistryCmd.MarkFlagRequired("namespace")
+	getRegistryCmd.MarkFlagRequired("name")
+	addOutputFlag(getRegistryCmd)
+	registryCmd.AddCommand(getRegistryCmd)
+
+	verifyRegistryCmd.Flags().StringP("namespace", "n", "", "Namespace of the existing registry")
+	verifyRegistryCmd.Flags().String("name", "", "Name of an existing private registry to take the source and username from")
+	verifyRegistryCmd.Flags().String("source", "", "Source URL for the private registry")
+	verifyRegistryCmd.Flags().String("username", "", "Username for the private registry")
+	verifyRegistryCmd.Flags().String("password", "", "Password for the private registry")
+	addRegistryCredentialFlags(verifyRegistryCmd)
+	registryCmd.AddCommand(verifyRegistryCmd)
+
+	updateRegistryCredentialsCmd.Flags().StringP("namespace", "n", "", "Namespace")
+	updateRegistryCredentialsCmd.Flags().String("name", "", "Name of the private registry")
+	updateRegistryCredentialsCmd.Flags().String("new-name", "", "Name for the replacement registry (default: name with a timestamp suffix)")
+	updateRegistryCredentialsCmd.Flags().String("username", "", "New username (default: the current username)")
+	updateRegistryCredentialsCmd.Flags().String("password", "", "New password for the private registry")
+	updateRegistryCredentialsCmd.Flags().Bool("skip-verify", false, "Skip verifying the credentials from this machine")
+	updateRegistryCredentialsCmd.Flags().Duration("timeout", 10*time.Minute, "Maximum time to wait for containers and jobs to switch registry")
+	updateRegistryCredentialsCmd.MarkFlagRequired("namespace")
+	updateRegistryCredentialsCmd.MarkFlagRequired("name")
+	addRegistryCredentialFlags(updateRegistryCredentialsCmd)
+	registryCmd.AddCommand(updateRegistryCredentialsCmd)
+
 	deleteRegistryCmd.Flags().StringP("namespace", "n", "", "Namespace")
 	deleteRegistryCmd.Flags().String("name", "", "Name of the private registry")
+	addConfirmFlag(deleteRegistryCmd)
 	deleteRegistryCmd.MarkFlagRequired("namespace")
 	deleteRegistryCmd.MarkFlagRequired("name")
 	registryCmd.AddCommand(deleteRegistryCmd)
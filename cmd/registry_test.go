@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRotatedRegistryName(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		registry string
+		expected string
+	}{
+		{name: "first rotation", registry: "gitlab", expected: "gitlab-20260304050607"},
+		{name: "earlier rotation", registry: "gitlab-20250101000000", expected: "gitlab-20260304050607"},
+		{name: "number that is not a timestamp", registry: "gitlab-2", expected: "gitlab-2-20260304050607"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := rotatedRegistryName(tt.registry, now); got != tt.expected {
+				t.Errorf("rotatedRegistryName(%q) = %q, expected %q", tt.registry, got, tt.expected)
+			}
+		})
+	}
+}
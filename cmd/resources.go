@@ -1,14 +1,177 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"math"
 	"os"
+	"sort"
 	"text/tabwriter"
 
 	"github.com/nexaa-cloud/nexaa-cli/api"
 	"github.com/spf13/cobra"
 )
 
+// resourceOption is a container resources value with its CPU, memory and monthly price in cents.
+type resourceOption struct {
+	Resources api.ContainerResources `json:"resources"`
+	Cpu       float64                `json:"cpu"`
+	Ram       float64                `json:"ram"`
+	Price     *int                   `json:"price"`
+	Currency  string                 `json:"currency"`
+}
+
+// resourceOptions parses all container resources values and joins them with the prices of the specifications.
+func resourceOptions(specifications []api.ResourceSpecificationResult) []resourceOption {
+	options := make([]resourceOption, 0, len(api.AllContainerResources))
+	for _, resources := range api.AllContainerResources {
+		cpu, ram, err := api.ParseContainerResources(resources)
+		if err != nil {
+			continue
+		}
+
+		option := resourceOption{Resources: resources, Cpu: cpu, Ram: ram}
+		if specification, ok := api.MatchResourceSpecification(resources, specifications); ok {
+			option.Price = specification.Price.Amount
+			if specification.Price.Currency != nil {
+				option.Currency = *specification.Price.Currency
+			}
+		}
+		options = append(options, option)
+	}
+	return options
+}
+
+// filterResourceOptions keeps the options with at least minCpu cores and minRam GB. When maxPrice is set,
+// in cents, options above it or without a known price are left out.
+func filterResourceOptions(options []resourceOption, minCpu float64, minRam float64, maxPrice int) []resourceOption {
+	var filtered []resourceOption
+	for _, option := range options {
+		if option.Cpu < minCpu || option.Ram < minRam {
+			continue
+		}
+		if maxPrice > 0 && (option.Price == nil || *option.Price > maxPrice) {
+			continue
+		}
+		filtered = append(filtered, option)
+	}
+	return filtered
+}
+
+// cheaper orders options by price, unknown prices last, then by CPU and memory.
+func cheaper(a resourceOption, b resourceOption) bool {
+	switch {
+	case a.Price != nil && b.Price == nil:
+		return true
+	case a.Price == nil && b.Price != nil:
+		return false
+	case a.Price != nil && *a.Price != *b.Price:
+		return *a.Price < *b.Price
+	case a.Cpu != b.Cpu:
+		return a.Cpu < b.Cpu
+	}
+	return a.Ram < b.Ram
+}
+
+func sortResourceOptions(options []resourceOption, by string) error {
+	var less func(a resourceOption, b resourceOption) bool
+	switch by {
+	case "cpu":
+		less = func(a resourceOption, b resourceOption) bool {
+			if a.Cpu != b.Cpu {
+				return a.Cpu < b.Cpu
+			}
+			return a.Ram < b.Ram
+		}
+	case "ram":
+		less = func(a resourceOption, b resourceOption) bool {
+			if a.Ram != b.Ram {
+				return a.Ram < b.Ram
+			}
+			return a.Cpu < b.Cpu
+		}
+	case "price":
+		less = cheaper
+	default:
+		return fmt.Errorf("unknown sort %q, use one of: cpu, ram, price", by)
+	}
+
+	sort.SliceStable(options, func(i, j int) bool { return less(options[i], options[j]) })
+	return nil
+}
+
+// recommendResources returns the cheapest option with at least the requested CPU cores and GB of memory.
+func recommendResources(options []resourceOption, cpu float64, ram float64) (resourceOption, error) {
+	candidates := filterResourceOptions(options, cpu, ram, 0)
+	if len(candidates) == 0 {
+		return resourceOption{}, fmt.Errorf("no resources with at least %g CPU and %g GB RAM", cpu, ram)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return cheaper(candidates[i], candidates[j]) })
+	return candidates[0], nil
+}
+
+// loadResourceOptions returns the resource options with their prices. When the specifications cannot be
+// fetched it returns the error together with the options without prices.
+func loadResourceOptions(ctx context.Context, client *api.Client) ([]resourceOption, error) {
+	specifications, err := client.ResourceSpecifications(ctx, api.ContainerSpecificationKind)
+	if err != nil {
+		return resourceOptions(nil), fmt.Errorf("failed to get resource specifications: %w", err)
+	}
+	return resourceOptions(specifications), nil
+}
+
+// mustLoadResourceOptions returns the resource options with their prices and exits when they cannot be fetched.
+func mustLoadResourceOptions(ctx context.Context, client *api.Client) []resourceOption {
+	options, err := loadResourceOptions(ctx, client)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	return options
+}
+
+// priceToCents converts an amount such as --max-price to cents, rounding so 19.99 is 1999 and not 1998.
+func priceToCents(amount float64) int {
+	return int(math.Round(amount * 100))
+}
+
+func formatOptionPrice(option resourceOption) string {
+	if option.Price == nil {
+		return "unknown"
+	}
+	return formatPrice(*option.Price, option.Currency)
+}
+
+// addResourcesFlags adds --resources and the --cpu and --ram alternative to a create or modify command.
+func addResourcesFlags(cmd *cobra.Command, usage string) {
+	cmd.Flags().String("resources", "", usage+" (e.g. CPU_250_RAM_500)")
+	cmd.Flags().Float64("cpu", 0, "Minimum CPU cores, picks the cheapest resources that fit instead of --resources")
+	cmd.Flags().Float64("ram", 0, "Minimum memory in GB, picks the cheapest resources that fit instead of --resources")
+	cmd.MarkFlagsMutuallyExclusive("resources", "cpu")
+	cmd.MarkFlagsMutuallyExclusive("resources", "ram")
+}
+
+// resourcesFromFlags returns --resources, or the cheapest resources that fit --cpu and --ram.
+// It returns an empty string when none of the flags are set.
+func resourcesFromFlags(cmd *cobra.Command) string {
+	resources, _ := cmd.Flags().GetString("resources")
+	if resources != "" || (!cmd.Flags().Changed("cpu") && !cmd.Flags().Changed("ram")) {
+		return resources
+	}
+
+	cpu, _ := cmd.Flags().GetFloat64("cpu")
+	ram, _ := cmd.Flags().GetFloat64("ram")
+
+	option, err := recommendResources(mustLoadResourceOptions(cmd.Context(), api.NewClient()), cpu, ram)
+	if err != nil {
+		log.Fatalf("Failed to pick resources: %v", err)
+	}
+	fmt.Fprintf(os.Stderr, "Using resources %s (%s per month).\n", option.Resources, formatOptionPrice(option))
+
+	return string(option.Resources)
+}
+
 var resourcesCmd = &cobra.Command{
 	Use:   "resources",
 	Short: "List Resources",
@@ -18,18 +181,65 @@ var listResourcesCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all resources",
 	Run: func(cmd *cobra.Command, args []string) {
-		resources := api.AllContainerResources
+		minCpu, _ := cmd.Flags().GetFloat64("min-cpu")
+		minRam, _ := cmd.Flags().GetFloat64("min-ram")
+		maxPrice, _ := cmd.Flags().GetFloat64("max-price")
+		sortBy, _ := cmd.Flags().GetString("sort")
+
+		options, err := loadResourceOptions(cmd.Context(), api.NewClient())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v, prices are unknown.\n", err)
+		}
+
+		options = filterResourceOptions(options, minCpu, minRam, priceToCents(maxPrice))
+		if err := sortResourceOptions(options, sortBy); err != nil {
+			log.Fatalf("%v", err)
+		}
+
+		if printOutput(cmd, options) {
+			return
+		}
+		if len(options) == 0 {
+			fmt.Println("No resources found.")
+			return
+		}
 
 		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.Debug)
 
-		fmt.Fprintln(writer, "RESOURCE\t")
-		for _, resource := range resources {
-			fmt.Fprintf(writer, "%s\t\n", resource)
+		fmt.Fprintln(writer, "RESOURCE\t CPU\t RAM\t PRICE\t")
+		for _, option := range options {
+			fmt.Fprintf(writer, "%s\t %g\t %g GB\t %s\t\n", option.Resources, option.Cpu, option.Ram, formatOptionPrice(option))
 		}
 		writer.Flush()
 	},
 }
 
+var recommendResourcesCmd = &cobra.Command{
+	Use:   "recommend",
+	Short: "Show the cheapest resources with at least the given CPU and memory",
+	Run: func(cmd *cobra.Command, args []string) {
+		cpu, _ := cmd.Flags().GetFloat64("cpu")
+		ram, _ := cmd.Flags().GetFloat64("ram")
+
+		option, err := recommendResources(mustLoadResourceOptions(cmd.Context(), api.NewClient()), cpu, ram)
+		if err != nil {
+			log.Fatalf("%v", err)
+		}
+
+		fmt.Printf("%s (%g CPU, %g GB RAM, %s per month)\n", option.Resources, option.Cpu, option.Ram, formatOptionPrice(option))
+	},
+}
+
 func init() {
+	listResourcesCmd.Flags().Float64("min-cpu", 0, "Only show resources with at least this many CPU cores")
+	listResourcesCmd.Flags().Float64("min-ram", 0, "Only show resources with at least this much memory in GB")
+	listResourcesCmd.Flags().Float64("max-price", 0, "Only show resources with a known monthly price up to this amount")
+	listResourcesCmd.Flags().String("sort", "cpu", "Sort by cpu, ram or price")
+	addOutputFlag(listResourcesCmd)
 	resourcesCmd.AddCommand(listResourcesCmd)
+
+	recommendResourcesCmd.Flags().Float64("cpu", 0, "Minimum CPU cores")
+	recommendResourcesCmd.Flags().Float64("ram", 0, "Minimum memory in GB")
+	recommendResourcesCmd.MarkFlagsOneRequired("cpu", "ram")
+	resourcesCmd.AddCommand(recommendResourcesCmd)
 }
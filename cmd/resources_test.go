@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+func testResourceOptions() []resourceOption {
+	amount := func(value int) *int { return &value }
+
+	return []resourceOption{
+		{Resources: api.ContainerResourcesCpu250Ram500, Cpu: 0.25, Ram: 0.5, Price: amount(500)},
+		{Resources: api.ContainerResourcesCpu1000Ram2000, Cpu: 1, Ram: 2, Price: amount(2000)},
+		{Resources: api.ContainerResourcesCpu750Ram2000, Cpu: 0.75, Ram: 2, Price: amount(2200)},
+		{Resources: api.ContainerResourcesCpu1000Ram1000, Cpu: 1, Ram: 1, Price: amount(1800)},
+		{Resources: api.ContainerResourcesCpu4000Ram16000, Cpu: 4, Ram: 16},
+	}
+}
+
+func optionNames(options []resourceOption) []api.ContainerResources {
+	names := make([]api.ContainerResources, len(options))
+	for i, option := range options {
+		names[i] = option.Resources
+	}
+	return names
+}
+
+func TestResourceOptionsJoinsPrices(t *testing.T) {
+	t.Parallel()
+
+	amount := 500
+	options := resourceOptions([]api.ResourceSpecificationResult{
+		{Cpu: 0.25, Ram: 0.5, Price: api.ResourceSpecificationResultPrice{Amount: &amount}},
+	})
+
+	if len(options) != len(api.AllContainerResources) {
+		t.Fatalf("resourceOptions() returned %d options, expected %d", len(options), len(api.AllContainerResources))
+	}
+	for _, option := range options {
+		priced := option.Price != nil
+		if priced != (option.Resources == api.ContainerResourcesCpu250Ram500) {
+			t.Errorf("resourceOptions() price of %s = %v", option.Resources, option.Price)
+		}
+	}
+}
+
+func TestResourceOptionsWithoutPrices(t *testing.T) {
+	t.Parallel()
+
+	options := resourceOptions(nil)
+	if len(options) != len(api.AllContainerResources) {
+		t.Fatalf("resourceOptions(nil) returned %d options, expected %d", len(options), len(api.AllContainerResources))
+	}
+	for _, option := range options {
+		if option.Cpu == 0 || option.Ram == 0 {
+			t.Errorf("resourceOptions(nil) did not parse %s: %g CPU, %g GB RAM", option.Resources, option.Cpu, option.Ram)
+		}
+		if price := formatOptionPrice(option); price != "unknown" {
+			t.Errorf("formatOptionPrice(%s) = %q, expected unknown", option.Resources, price)
+		}
+	}
+}
+
+func TestFilterAndSortResourceOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		minCpu   float64
+		minRam   float64
+		maxPrice int
+		sort     string
+		expected []api.ContainerResources
+	}{
+		{
+			name:     "minimum cpu sorted by cpu",
+			minCpu:   0.75,
+			sort:     "cpu",
+			expected: []api.ContainerResources{api.ContainerResourcesCpu750Ram2000, api.ContainerResourcesCpu1000Ram1000, api.ContainerResourcesCpu1000Ram2000, api.ContainerResourcesCpu4000Ram16000},
+		},
+		{
+			name:     "maximum price leaves out unknown prices",
+			maxPrice: 2000,
+			sort:     "price",
+			expected: []api.ContainerResources{api.ContainerResourcesCpu250Ram500, api.ContainerResourcesCpu1000Ram1000, api.ContainerResourcesCpu1000Ram2000},
+		},
+		{
+			name:     "minimum ram sorted by ram",
+			minRam:   2,
+			sort:     "ram",
+			expected: []api.ContainerResources{api.ContainerResourcesCpu750Ram2000, api.ContainerResourcesCpu1000Ram2000, api.ContainerResourcesCpu4000Ram16000},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			options := filterResourceOptions(testResourceOptions(), tt.minCpu, tt.minRam, tt.maxPrice)
+			if err := sortResourceOptions(options, tt.sort); err != nil {
+				t.Fatalf("sortResourceOptions() error = %v", err)
+			}
+			if got := optionNames(options); !slices.Equal(got, tt.expected) {
+				t.Errorf("filtered options = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+
+	if err := sortResourceOptions(testResourceOptions(), "name"); err == nil {
+		t.Errorf("sortResourceOptions() with an unknown sort returned no error")
+	}
+}
+
+func TestFilterResourceOptionsMaxPriceInCents(t *testing.T) {
+	t.Parallel()
+
+	amount := func(value int) *int { return &value }
+	options := []resourceOption{
+		{Resources: api.ContainerResourcesCpu250Ram500, Cpu: 0.25, Ram: 0.5, Price: amount(29)},
+		{Resources: api.ContainerResourcesCpu1000Ram2000, Cpu: 1, Ram: 2, Price: amount(1999)},
+		{Resources: api.ContainerResourcesCpu1000Ram1000, Cpu: 1, Ram: 1, Price: amount(2000)},
+	}
+
+	tests := []struct {
+		maxPrice float64
+		expected []api.ContainerResources
+	}{
+		{maxPrice: 0.29, expected: []api.ContainerResources{api.ContainerResourcesCpu250Ram500}},
+		{maxPrice: 19.99, expected: []api.ContainerResources{api.ContainerResourcesCpu250Ram500, api.ContainerResourcesCpu1000Ram2000}},
+	}
+
+	for _, tt := range tests {
+		if got := optionNames(filterResourceOptions(options, 0, 0, priceToCents(tt.maxPrice))); !slices.Equal(got, tt.expected) {
+			t.Errorf("filterResourceOptions() with a maximum price of %g = %v, expected %v", tt.maxPrice, got, tt.expected)
+		}
+	}
+}
+
+func TestRecommendResources(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		cpu      float64
+		ram      float64
+		expected api.ContainerResources
+		wantErr  bool
+	}{
+		{cpu: 0.6, ram: 1.5, expected: api.ContainerResourcesCpu1000Ram2000},
+		{cpu: 0.2, ram: 0.5, expected: api.ContainerResourcesCpu250Ram500},
+		{cpu: 2, expected: api.ContainerResourcesCpu4000Ram16000},
+		{cpu: 8, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		option, err := recommendResources(testResourceOptions(), tt.cpu, tt.ram)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("recommendResources(%g, %g) error = %v, wantErr %v", tt.cpu, tt.ram, err, tt.wantErr)
+		}
+		if option.Resources != tt.expected {
+			t.Errorf("recommendResources(%g, %g) = %s, expected %s", tt.cpu, tt.ram, option.Resources, tt.expected)
+		}
+	}
+}
@@ -9,32 +9,6 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var completionCmd = &cobra.Command{
-	Use:   "completion",
-	Short: "Generate shell completion script",
-	Long: `To enable shell completion, run:
-
-For Bash:
-    source <(nexaa completion bash)
-
-For Zsh:
-    source <(nexaa completion zsh)
-
-Or to persist it, save the output to a file and source it in your shell config.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		var err error
-		if len(args) > 0 && args[0] == "zsh" {
-			err = cmd.Root().GenZshCompletion(os.Stdout)
-		} else {
-			err = cmd.Root().GenBashCompletion(os.Stdout)
-		}
-		if err != nil {
-			fmt.Printf("Error generating completion: %v\n", err)
-			os.Exit(1)
-		}
-	},
-}
-
 var rootCmd = &cobra.Command{
 	Use:   "nexaa",
 	Short: "A CLI tool to manage cloud resources on the Nexaa Serverless Platform.",
@@ -45,7 +19,7 @@ var rootCmd = &cobra.Command{
 			log.Fatalf("Failed to load config: %v", err)
 		}
 
-		if config.AccessToken == "" && cmd.Name() != "login" {
+		if config.AccessToken == "" && !noLoginRequired(cmd) {
 			fmt.Println("ERROR: No access token found, please login first.")
 			fmt.Println("Run 'nexaa login' to authenticate.")
 			os.Exit(1)
@@ -53,7 +27,18 @@ var rootCmd = &cobra.Command{
 	},
 }
 
+// noLoginRequired reports whether the command works without an access token. Shell completion requests
+// are answered without names in that case instead of failing.
+func noLoginRequired(cmd *cobra.Command) bool {
+	switch cmd.Name() {
+	case "login", completionCmd.Name(), cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+		return true
+	}
+	return false
+}
+
 func Execute() {
+	registerCompletions(rootCmd)
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
 		os.Exit(1)
@@ -73,4 +58,10 @@ func init() {
 	rootCmd.AddCommand(volumeCmd)
 	rootCmd.AddCommand(resourcesCmd)
 	rootCmd.AddCommand(messageQueueCmd)
+	rootCmd.AddCommand(rotateCmd)
+	rootCmd.AddCommand(watchCmd)
+	rootCmd.AddCommand(tuiCmd)
+	rootCmd.AddCommand(auditCmd)
+	rootCmd.AddCommand(costCmd)
+	rootCmd.AddCommand(apiCmd)
 }
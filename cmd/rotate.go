@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log"
+	"math/big"
+	"time"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+	"github.com/spf13/cobra"
+)
+
+const passwordAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// generatePassword returns a random password using only URL and shell safe characters.
+func generatePassword(length int) (string, error) {
+	if length < 16 {
+		return "", fmt.Errorf("password length must be at least 16, got %d", length)
+	}
+
+	max := big.NewInt(int64(len(passwordAlphabet)))
+	password := make([]byte, length)
+	for i := range password {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		password[i] = passwordAlphabet[n.Int64()]
+	}
+
+	return string(password), nil
+}
+
+// updateContainerSecrets stores the secret in every container and optionally waits for them to be ready again.
+func updateContainerSecrets(ctx context.Context, client *api.Client, namespace string, containers []string, envName string, value string, wait bool, timeout time.Duration) {
+	for _, container := range containers {
+		if _, err := setContainerSecret(ctx, client, namespace, container, envName, value); err != nil {
+			log.Fatalf("Failed to update %s in container %q/%q: %v", envName, namespace, container, err)
+		}
+		fmt.Printf("Updated %s in container %q/%q.\n", envName, namespace, container)
+	}
+
+	if !wait {
+		return
+	}
+
+	for _, container := range containers {
+		fmt.Printf("Waiting for container %q/%q to be ready...\n", namespace, container)
+		if err := waitForContainerReplicas(ctx, client, namespace, container, timeout); err != nil {
+			log.Fatalf("Container %q/%q is not ready: %v", namespace, container, err)
+		}
+		fmt.Printf("Container %q/%q is ready.\n", namespace, container)
+	}
+}
+
+var rotateCmd = &cobra.Command{
+	Use:   "rotate",
+	Short: "Rotate credentials of database and message queue users",
+}
+
+var rotateDatabaseUserCmd = &cobra.Command{
+	Use:   "db-user",
+	Short: "Rotate the password of a cloud database cluster user and update the containers using it",
+	Run: func(cmd *cobra.Command, args []string) {
+		namespace, _ := cmd.Flags().GetString("namespace")
+		clusterName, _ := cmd.Flags().GetString("cluster")
+		userName, _ := cmd.Flags().GetString("user")
+		database, _ := cmd.Flags().GetString("database")
+		containers, _ := cmd.Flags().GetString("containers")
+		envName, _ := cmd.Flags().GetString("env-name")
+		length, _ := cmd.Flags().GetInt("length")
+		wait, _ := cmd.Flags().GetBool("wait")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+
+		password, err := generatePassword(length)
+		if err != nil {
+			log.Fatalf("Failed to generate password: %v", err)
+		}
+
+		resource := api.CloudDatabaseClusterResourceInput{
+			Name:      clusterName,
+			Namespace: namespace,
+		}
+
+		client := api.NewClient()
+
+		cluster, err := client.CloudDatabaseClusterGet(cmd.Context(), resource)
+		if err != nil {
+			log.Fatalf("Failed to get cloud database cluster: %v", err)
+		}
+
+		user, err := client.CloudDatabaseClusterUserModify(cmd.Context(), api.CloudDatabaseClusterUserModifyInput{
+			Cluster: &resource,
+			User: &api.DatabaseUserInput{
+				Name:        userName,
+				Password:    &password,
+				State:       api.StatePresent,
+				Permissions: []api.DatabaseUserPermissionInput{},
+			},
+		})
+		if err != nil {
+			log.Fatalf("Failed to rotate password of user %q in cluster %q/%q: %v", userName, namespace, clusterName, err)
+		}
+		fmt.Printf("Password of user %q rotated.\n", user.Name)
+
+		databaseUrl, err := buildConnectionString("url", connectionDetails{
+			Type:     cluster.Spec.Type,
+			Host:     cluster.Hostname,
+			Port:     cluster.Port,
+			User:     userName,
+			Password: password,
+			Database: database,
+		})
+		if err != nil {
+			log.Fatalf("Failed to build connection string: %v", err)
+		}
+
+		updateContainerSecrets(cmd.Context(), client, namespace, splitAndTrim(containers), envName, databaseUrl, wait, timeout)
+	},
+}
+
+var rotateMessageQueueUserCmd = &cobra.Command{
+	Use:   "queue-user",
+	Short: "Rotate the password of a message queue user and update the containers using it",
+	Run: func(cmd *cobra.Command, args []string) {
+		namespace, _ := cmd.Flags().GetString("namespace")
+		queueName, _ := cmd.Flags().GetString("queue")
+		userName, _ := cmd.Flags().GetString("user")
+		containers, _ := cmd.Flags().GetString("containers")
+		envName, _ := cmd.Flags().GetString("env-name")
+		length, _ := cmd.Flags().GetInt("length")
+		wait, _ := cmd.Flags().GetBool("wait")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+
+		password, err := generatePassword(length)
+		if err != nil {
+			log.Fatalf("Failed to generate password: %v", err)
+		}
+
+		client := api.NewClient()
+
+		user, err := client.MessageQueueUserModify(cmd.Context(), api.CloudDatabaseClusterUserModifyInput{
+			Cluster: &api.CloudDatabaseClusterResourceInput{
+				Name:      queueName,
+				Namespace: namespace,
+			},
+			User: &api.DatabaseUserInput{
+				Name:        userName,
+				Password:    &password,
+				State:       api.StatePresent,
+				Permissions: []api.DatabaseUserPermissionInput{},
+			},
+		})
+		if err != nil {
+			log.Fatalf("Failed to rotate password of user %q in message queue %q/%q: %v", userName, namespace, queueName, err)
+		}
+		fmt.Printf("Password of user %q rotated.\n", user.Name)
+
+		updateContainerSecrets(cmd.Context(), client, namespace, splitAndTrim(containers), envName, user.Dsn, wait, timeout)
+	},
+}
+
+func init() {
+	rotateDatabaseUserCmd.Flags().StringP("namespace", "n", "", "Namespace")
+	rotateDatabaseUserCmd.Flags().String("cluster", "", "Name of the cluster")
+	rotateDatabaseUserCmd.Flags().String("user", "", "User name")
+	rotateDatabaseUserCmd.Flags().String("database", "", "Database name used in the connection URL")
+	rotateDatabaseUserCmd.Flags().String("containers", "", "Comma-separated list of containers to update")
+	rotateDatabaseUserCmd.Flags().String("env-name", "DATABASE_URL", "Name of the secret holding the connection URL")
+	rotateDatabaseUserCmd.Flags().Int("length", 32, "Length of the generated password")
+	rotateDatabaseUserCmd.Flags().Bool("wait", false, "Wait until every container is back to its full replica count")
+	rotateDatabaseUserCmd.Flags().Duration("timeout", 5*time.Minute, "Maximum time to wait for each container")
+	rotateDatabaseUserCmd.MarkFlagRequired("namespace")
+	rotateDatabaseUserCmd.MarkFlagRequired("cluster")
+	rotateDatabaseUserCmd.MarkFlagRequired("user")
+	rotateCmd.AddCommand(rotateDatabaseUserCmd)
+
+	rotateMessageQueueUserCmd.Flags().StringP("namespace", "n", "", "Namespace")
+	rotateMessageQueueUserCmd.Flags().String("queue", "", "Name of the message queue")
+	rotateMessageQueueUserCmd.Flags().String("user", "", "User name")
+	rotateMessageQueueUserCmd.Flags().String("containers", "", "Comma-separated list of containers to update")
+	rotateMessageQueueUserCmd.Flags().String("env-name", "AMQP_URL", "Name of the secret holding the connection URL")
+	rotateMessageQueueUserCmd.Flags().Int("length", 32, "Length of the generated password")
+	rotateMessageQueueUserCmd.Flags().Bool("wait", false, "Wait until every container is back to its full replica count")
+	rotateMessageQueueUserCmd.Flags().Duration("timeout", 5*time.Minute, "Maximum time to wait for each container")
+	rotateMessageQueueUserCmd.MarkFlagRequired("namespace")
+	rotateMessageQueueUserCmd.MarkFlagRequired("queue")
+	rotateMessageQueueUserCmd.MarkFlagRequired("user")
+	rotateCmd.AddCommand(rotateMessageQueueUserCmd)
+}
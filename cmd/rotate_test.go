@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGeneratePassword(t *testing.T) {
+	t.Parallel()
+
+	first, err := generatePassword(32)
+	if err != nil {
+		t.Fatalf("generatePassword(32) returned error: %v", err)
+	}
+	if len(first) != 32 {
+		t.Errorf("generatePassword(32) returned %d characters", len(first))
+	}
+	for _, c := range first {
+		if !strings.ContainsRune(passwordAlphabet, c) {
+			t.Errorf("generatePassword(32) returned unexpected character %q", c)
+		}
+	}
+
+	second, _ := generatePassword(32)
+	if first == second {
+		t.Errorf("generatePassword(32) returned the same password twice")
+	}
+
+	if _, err := generatePassword(8); err == nil {
+		t.Errorf("generatePassword(8) expected an error for a short password")
+	}
+}
@@ -0,0 +1,295 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// dockerConfigSourceHost is the value of --from-docker-config when no host is given,
+// the host is then taken from the registry source.
+const dockerConfigSourceHost = "source"
+
+// dockerHubServer is the key Docker uses for Docker Hub credentials.
+const dockerHubServer = "https://index.docker.io/v1/"
+
+type dockerAuth struct {
+	Auth          string `json:"auth"`
+	Username      string `json:"username"`
+	Password      string `json:"password"`
+	IdentityToken string `json:"identitytoken"`
+}
+
+type dockerConfig struct {
+	Auths       map[string]dockerAuth `json:"auths"`
+	CredsStore  string                `json:"credsStore"`
+	CredHelpers map[string]string     `json:"credHelpers"`
+}
+
+// credentialHelper returns the username and secret stored by a docker-credential-<helper> for the server.
+type credentialHelper func(helper string, server string) (string, string, error)
+
+// readSecret reads a secret from the reader, dropping the trailing newline added by echo or a file.
+func readSecret(reader io.Reader) (string, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+
+	secret := strings.TrimRight(string(data), "\r\n")
+	if secret == "" {
+		return "", fmt.Errorf("no secret received on stdin")
+	}
+	return secret, nil
+}
+
+// addPasswordStdinFlag registers --password-stdin next to an existing --password flag.
+func addPasswordStdinFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("password-stdin", false, "Read the password from stdin")
+	cmd.MarkFlagsMutuallyExclusive("password", "password-stdin")
+}
+
+// passwordFromFlags returns the value of --password, or reads it from stdin when --password-stdin is set.
+func passwordFromFlags(cmd *cobra.Command) string {
+	password, _ := cmd.Flags().GetString("password")
+	if fromStdin, _ := cmd.Flags().GetBool("password-stdin"); !fromStdin {
+		return password
+	}
+
+	password, err := readSecret(os.Stdin)
+	if err != nil {
+		log.Fatalf("Failed to read password from stdin: %v", err)
+	}
+	return password
+}
+
+// resolveSecretValues reads the value of a NAME=- secret from stdin and of a NAME=@path secret from the file.
+// Like with stdin, a trailing newline at the end of a file is dropped.
+func resolveSecretValues(secrets []string, stdin io.Reader, readFile func(string) ([]byte, error)) ([]string, error) {
+	resolved := make([]string, len(secrets))
+	fromStdin := ""
+
+	for i, secret := range secrets {
+		name, value, _ := strings.Cut(secret, "=")
+
+		switch {
+		case value == "-":
+			if fromStdin != "" {
+				return nil, fmt.Errorf("only one secret can be read from stdin, both %s and %s use -", fromStdin, name)
+			}
+			fromStdin = name
+
+			value, err := readSecret(stdin)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read secret %s: %w", name, err)
+			}
+			resolved[i] = name + "=" + value
+		case strings.HasPrefix(value, "@"):
+			data, err := readFile(value[1:])
+			if err != nil {
+				return nil, fmt.Errorf("failed to read secret %s: %w", name, err)
+			}
+			resolved[i] = name + "=" + strings.TrimRight(string(data), "\r\n")
+		default:
+			resolved[i] = secret
+		}
+	}
+
+	return resolved, nil
+}
+
+// secretsFromFlags returns the --secret values with NAME=- read from stdin and NAME=@path read from files.
+func secretsFromFlags(cmd *cobra.Command) []string {
+	secrets, _ := cmd.Flags().GetStringArray("secret")
+
+	resolved, err := resolveSecretValues(secrets, os.Stdin, os.ReadFile)
+	if err != nil {
+		log.Fatalf("Invalid secret: %v", err)
+	}
+	return resolved
+}
+
+// registryHost returns the host of a registry source or docker config key,
+// with all Docker Hub aliases reduced to docker.io.
+func registryHost(source string) string {
+	if !strings.Contains(source, "://") {
+		source = "https://" + source
+	}
+
+	host := source
+	if parsed, err := url.Parse(source); err == nil && parsed.Host != "" {
+		host = parsed.Host
+	}
+
+	switch host {
+	case "index.docker.io", "registry-1.docker.io", "registry.hub.docker.com":
+		return "docker.io"
+	}
+	return host
+}
+
+func dockerConfigPath() (string, error) {
+	if dir := os.Getenv("DOCKER_CONFIG"); dir != "" {
+		return filepath.Join(dir, "config.json"), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".docker", "config.json"), nil
+}
+
+func loadDockerConfig() (dockerConfig, error) {
+	path, err := dockerConfigPath()
+	if err != nil {
+		return dockerConfig{}, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return dockerConfig{}, err
+	}
+
+	var config dockerConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return dockerConfig{}, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return config, nil
+}
+
+// dockerCredentials looks up the credentials for the host the same way the docker CLI does:
+// a credHelpers entry first, then an auths entry and finally the credsStore.
+func dockerCredentials(config dockerConfig, host string, helper credentialHelper) (string, string, error) {
+	host = registryHost(host)
+
+	server := host
+	if host == "docker.io" {
+		server = dockerHubServer
+	}
+
+	for key, name := range config.CredHelpers {
+		if registryHost(key) == host {
+			return helper(name, key)
+		}
+	}
+
+	for key, auth := range config.Auths {
+		if registryHost(key) != host {
+			continue
+		}
+		server = key
+
+		// Docker stores an empty password next to an identity token, which is an OAuth refresh token a
+		// private registry cannot log in with.
+		if auth.IdentityToken != "" {
+			return "", "", fmt.Errorf("the docker config only has an identity token for %s, identity tokens are not supported, pass --password or --password-stdin instead", key)
+		}
+
+		if auth.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+			if err != nil {
+				return "", "", fmt.Errorf("invalid auth entry for %s: %w", key, err)
+			}
+			username, password, ok := strings.Cut(string(decoded), ":")
+			if !ok {
+				return "", "", fmt.Errorf("invalid auth entry for %s: expected username:password", key)
+			}
+			return username, password, nil
+		}
+
+		if auth.Username != "" && auth.Password != "" {
+			return auth.Username, auth.Password, nil
+		}
+	}
+
+	if config.CredsStore != "" {
+		return helper(config.CredsStore, server)
+	}
+
+	return "", "", fmt.Errorf("no credentials for %s found in the docker config", host)
+}
+
+// execCredentialHelper runs `docker-credential-<helper> get` with the server on stdin.
+func execCredentialHelper(helper string, server string) (string, string, error) {
+	command := exec.Command("docker-credential-"+helper, "get")
+	command.Stdin = strings.NewReader(server)
+
+	var stderr bytes.Buffer
+	command.Stderr = &stderr
+
+	output, err := command.Output()
+	if err != nil {
+		return "", "", fmt.Errorf("docker-credential-%s failed: %v %s", helper, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var credentials struct {
+		Username string `json:"Username"`
+		Secret   string `json:"Secret"`
+	}
+	if err := json.Unmarshal(output, &credentials); err != nil {
+		return "", "", fmt.Errorf("failed to parse output of docker-credential-%s: %w", helper, err)
+	}
+	return credentials.Username, credentials.Secret, nil
+}
+
+// registryCredentialsFromFlags resolves the registry username and password from --username, --password,
+// --password-stdin or --from-docker-config.
+func registryCredentialsFromFlags(cmd *cobra.Command, source string) (string, string) {
+	username, _ := cmd.Flags().GetString("username")
+
+	if !cmd.Flags().Changed("from-docker-config") {
+		return username, passwordFromFlags(cmd)
+	}
+
+	host, _ := cmd.Flags().GetString("from-docker-config")
+	if host == dockerConfigSourceHost {
+		host = source
+	}
+
+	config, err := loadDockerConfig()
+	if err != nil {
+		log.Fatalf("Failed to read docker config: %v", err)
+	}
+
+	configUsername, password, err := dockerCredentials(config, host, execCredentialHelper)
+	if err != nil {
+		log.Fatalf("Failed to get credentials from docker config: %v", err)
+	}
+
+	if username == "" {
+		username = configUsername
+	}
+	return username, password
+}
+
+// noDockerConfigHostArgs rejects positional arguments. Because the host of --from-docker-config is optional,
+// `--from-docker-config ghcr.io` leaves the host behind as an argument, it has to be passed with an equals sign.
+func noDockerConfigHostArgs(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return nil
+	}
+	if host, _ := cmd.Flags().GetString("from-docker-config"); host == dockerConfigSourceHost {
+		return fmt.Errorf("unexpected argument %q, pass the registry host as --from-docker-config=%s", args[0], args[0])
+	}
+	return fmt.Errorf("unexpected argument %q", args[0])
+}
+
+// addRegistryCredentialFlags registers --password-stdin and --from-docker-config next to --password.
+func addRegistryCredentialFlags(cmd *cobra.Command) {
+	addPasswordStdinFlag(cmd)
+	cmd.Flags().String("from-docker-config", "", "Read the credentials from the docker config, optionally for the given registry host as --from-docker-config=HOST (default: the registry source)")
+	cmd.Flags().Lookup("from-docker-config").NoOptDefVal = dockerConfigSourceHost
+	cmd.MarkFlagsMutuallyExclusive("password", "password-stdin", "from-docker-config")
+	cmd.Args = noDockerConfigHostArgs
+}
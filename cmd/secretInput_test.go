@@ -0,0 +1,200 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestReadSecret(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{name: "trailing newline is dropped", input: "s3cret\n", expected: "s3cret"},
+		{name: "windows newline is dropped", input: "s3cret\r\n", expected: "s3cret"},
+		{name: "spaces are kept", input: " s3cret ", expected: " s3cret "},
+		{name: "empty input", input: "\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := readSecret(strings.NewReader(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readSecret() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("readSecret() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestResolveSecretValues(t *testing.T) {
+	t.Parallel()
+
+	readFile := func(path string) ([]byte, error) {
+		if path == "token.txt" {
+			return []byte("from-file\n"), nil
+		}
+		return nil, fmt.Errorf("open %s: no such file or directory", path)
+	}
+
+	tests := []struct {
+		name     string
+		secrets  []string
+		stdin    string
+		expected []string
+		wantErr  bool
+	}{
+		{name: "plain values", secrets: []string{"A=1", "B=a=b"}, expected: []string{"A=1", "B=a=b"}},
+		{name: "one stdin secret", secrets: []string{"A=1", "TOKEN=-"}, stdin: "abc\n", expected: []string{"A=1", "TOKEN=abc"}},
+		{name: "two stdin secrets", secrets: []string{"A=-", "B=-"}, stdin: "abc", wantErr: true},
+		{name: "empty stdin", secrets: []string{"A=-"}, wantErr: true},
+		{name: "file secret", secrets: []string{"TOKEN=@token.txt"}, expected: []string{"TOKEN=from-file"}},
+		{name: "missing file", secrets: []string{"TOKEN=@missing.txt"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := resolveSecretValues(tt.secrets, strings.NewReader(tt.stdin), readFile)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveSecretValues() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("resolveSecretValues() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRegistryHost(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		source   string
+		expected string
+	}{
+		{source: "registry.gitlab.com/group/project", expected: "registry.gitlab.com"},
+		{source: "https://ghcr.io", expected: "ghcr.io"},
+		{source: "https://index.docker.io/v1/", expected: "docker.io"},
+		{source: "docker.io", expected: "docker.io"},
+		{source: "localhost:5000", expected: "localhost:5000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			t.Parallel()
+
+			if got := registryHost(tt.source); got != tt.expected {
+				t.Errorf("registryHost(%q) = %q, expected %q", tt.source, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDockerCredentials(t *testing.T) {
+	t.Parallel()
+
+	helper := func(name string, server string) (string, string, error) {
+		return name + "-user", server + "-secret", nil
+	}
+
+	config := dockerConfig{
+		Auths: map[string]dockerAuth{
+			"registry.gitlab.com":         {Auth: "ZGVwbG95OnRva2Vu"}, // deploy:token
+			"https://index.docker.io/v1/": {},
+			"quay.io":                     {Username: "robot", Password: "pw"},
+			"broken.example.com":          {Auth: "not base64!"},
+			"myregistry.azurecr.io":       {Auth: "MDAwMDAwMDAtMDAwMC0wMDAwLTAwMDAtMDAwMDAwMDAwMDAwOg==", IdentityToken: "eyJhbGciOi"},
+		},
+		CredsStore:  "desktop",
+		CredHelpers: map[string]string{"gcr.io": "gcloud"},
+	}
+
+	tests := []struct {
+		name     string
+		host     string
+		username string
+		password string
+		wantErr  bool
+	}{
+		{name: "base64 auth entry", host: "registry.gitlab.com/group/project", username: "deploy", password: "token"},
+		{name: "plain auth entry", host: "quay.io", username: "robot", password: "pw"},
+		{name: "credential helper", host: "gcr.io", username: "gcloud-user", password: "gcr.io-secret"},
+		{name: "credential store", host: "docker.io", username: "desktop-user", password: "https://index.docker.io/v1/-secret"},
+		{name: "invalid auth entry", host: "broken.example.com", wantErr: true},
+		{name: "identity token", host: "myregistry.azurecr.io", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			username, password, err := dockerCredentials(config, tt.host, helper)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("dockerCredentials() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if username != tt.username || password != tt.password {
+				t.Errorf("dockerCredentials() = %q, %q, expected %q, %q", username, password, tt.username, tt.password)
+			}
+		})
+	}
+
+	_, _, err := dockerCredentials(dockerConfig{}, "ghcr.io", func(string, string) (string, string, error) {
+		return "", "", fmt.Errorf("helper should not be called")
+	})
+	if err == nil {
+		t.Errorf("dockerCredentials() without matching entry returned no error")
+	}
+}
+
+func TestNoDockerConfigHostArgs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "host with equals sign", args: []string{"--from-docker-config=ghcr.io"}},
+		{name: "no host", args: []string{"--from-docker-config"}},
+		{name: "host with space", args: []string{"--from-docker-config", "ghcr.io"}, wantErr: "--from-docker-config=ghcr.io"},
+		{name: "stray argument", args: []string{"--password", "secret", "extra"}, wantErr: `unexpected argument "extra"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cmd := &cobra.Command{Use: "verify", Run: func(*cobra.Command, []string) {}}
+			cmd.Flags().String("password", "", "Password")
+			addRegistryCredentialFlags(cmd)
+			cmd.SetArgs(tt.args)
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			err := cmd.Execute()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Execute(%q) unexpected error: %v", tt.args, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Execute(%q) error = %v, want %q", tt.args, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+	"github.com/nexaa-cloud/nexaa-cli/tui"
+	"github.com/spf13/cobra"
+)
+
+var tuiCmd = &cobra.Command{
+	Use:   "tui",
+	Short: "Browse and operate resources in an interactive terminal UI",
+	Long: `Opens an interactive terminal UI with the namespaces on the left and their resources on the right.
+
+Keys:
+  up/down, j/k   move the selection
+  tab, left      switch between the panes
+  enter, d       open a namespace or describe a resource
+  e              show environment variables of a container or job
+  c              show external connections of a container
+  r              show the runs of a job
+  s              scale a container
+  x              delete a resource that is not in use after typing its name
+  R              refresh now
+  q              quit`,
+	Run: func(cmd *cobra.Command, args []string) {
+		namespace, _ := cmd.Flags().GetString("namespace")
+		refresh, _ := cmd.Flags().GetDuration("refresh")
+
+		if refresh <= 0 {
+			log.Fatalf("--refresh must be positive")
+		}
+
+		client := api.NewClient()
+		if err := tui.Run(cmd.Context(), client, tuiDependents(client), refresh, namespace); err != nil {
+			log.Fatalf("Failed to run terminal UI: %v", err)
+		}
+	},
+}
+
+// tuiDependents checks the resources deleted from the terminal UI the way the delete commands do.
+func tuiDependents(client *api.Client) tui.DependentsFunc {
+	return func(ctx context.Context, namespace string, kind string, name string) ([]string, error) {
+		switch kind {
+		case tui.KindVolume:
+			volume, err := client.ListVolumeByName(ctx, namespace, name)
+			if err != nil || volume == nil {
+				return nil, err
+			}
+			return volumeMountedBy(*volume), nil
+		case tui.KindRegistry, tui.KindDatabaseCluster:
+		default:
+			return nil, nil
+		}
+
+		containers, err := client.ListContainers(ctx, namespace)
+		if err != nil {
+			return nil, err
+		}
+		jobs, err := client.ContainerJobList(ctx, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if kind == tui.KindRegistry {
+			return registryDependents(name, containers, jobs), nil
+		}
+
+		cluster, err := client.CloudDatabaseClusterGet(ctx, api.CloudDatabaseClusterResourceInput{Namespace: namespace, Name: name})
+		if err != nil {
+			return nil, err
+		}
+		return hostnameDependents(cluster.Hostname, containers, jobs), nil
+	}
+}
+
+func init() {
+	tuiCmd.Flags().StringP("namespace", "n", "", "Namespace to open at start")
+	tuiCmd.Flags().Duration("refresh", 10*time.Second, "Interval to refresh the resources")
+}
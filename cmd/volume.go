@@ -3,8 +3,11 @@ package cmd
 import (
 	"fmt"
 	"log"
+	"math"
 	"os"
+	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/nexaa-cloud/nexaa-cli/api"
 	"github.com/spf13/cobra"
@@ -15,6 +18,54 @@ var volumeCmd = &cobra.Command{
 	Short: "Manage persistent volumes",
 }
 
+// formatGigabytes renders a size in GB in the most readable unit.
+func formatGigabytes(gb float64) string {
+	switch {
+	case gb < 1:
+		return fmt.Sprintf("%.0f MB", gb*1024)
+	case gb >= 1024:
+		return fmt.Sprintf("%.2f TB", gb/1024)
+	}
+	return fmt.Sprintf("%.2f GB", gb)
+}
+
+func usagePercentage(volume api.VolumeResult) float64 {
+	if volume.Size <= 0 {
+		return 0
+	}
+	return volume.Usage / volume.Size * 100
+}
+
+func volumeMountedBy(volume api.VolumeResult) []string {
+	var mountedBy []string
+	for _, container := range volume.Containers {
+		mountedBy = append(mountedBy, "container/"+container.Name)
+	}
+	for _, job := range volume.ContainerJobs {
+		mountedBy = append(mountedBy, "job/"+job.Name)
+	}
+	return mountedBy
+}
+
+// volumeNewSize calculates the target size in GB for `volume increase`.
+func volumeNewSize(current float64, to int, by int) (int, error) {
+	if (to == 0) == (by == 0) {
+		return 0, fmt.Errorf("pass either --to or --by")
+	}
+
+	if by != 0 {
+		if by < 0 {
+			return 0, fmt.Errorf("--by must be positive, got %d", by)
+		}
+		return int(math.Ceil(current)) + by, nil
+	}
+
+	if float64(to) <= current {
+		return 0, fmt.Errorf("volumes can only grow, the new size of %dGB is not larger than the current size of %s", to, formatGigabytes(current))
+	}
+	return to, nil
+}
+
 var listVolumesCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all persistent volumes",
@@ -22,12 +73,16 @@ var listVolumesCmd = &cobra.Command{
 		namespace, _ := cmd.Flags().GetString("namespace")
 		client := api.NewClient()
 
-		volumes, err := client.ListVolumes(namespace)
+		volumes, err := client.ListVolumes(cmd.Context(), namespace)
 		if err != nil {
 			log.Fatalf("Failed to list volumes: %v", err)
 			return
 		}
 
+		if printOutput(cmd, volumes) {
+			return
+		}
+
 		if len(volumes) == 0 {
 			fmt.Println("No volumes found.")
 			return
@@ -35,16 +90,108 @@ var listVolumesCmd = &cobra.Command{
 
 		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.Debug)
 
-		fmt.Fprintln(writer, "NAME\t SIZE\t USAGE\t")
+		fmt.Fprintln(writer, "NAME\t SIZE\t USAGE\t USED\t STATE\t LOCKED\t MOUNTED BY\t")
 
 		for _, volume := range volumes {
-			fmt.Fprintf(writer, "%s\t %f\t %f	\t", volume.Name, volume.Size, volume.Usage)
+			fmt.Fprintf(
+				writer,
+				"%s\t %s\t %s\t %.0f%%\t %s\t %t\t %s\t\n",
+				volume.Name,
+				formatGigabytes(volume.Size),
+				formatGigabytes(volume.Usage),
+				usagePercentage(volume),
+				volume.State,
+				volume.Locked,
+				strings.Join(volumeMountedBy(volume), ", "),
+			)
 		}
 
 		writer.Flush()
 	},
 }
 
+var getVolumeCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Get details of a persistent volume",
+	Run: func(cmd *cobra.Command, args []string) {
+		namespace, _ := cmd.Flags().GetString("namespace")
+		name, _ := cmd.Flags().GetString("name")
+		client := api.NewClient()
+
+		volume, err := client.ListVolumeByName(cmd.Context(), namespace, name)
+		if err != nil {
+			log.Fatalf("Failed to get volume: %v", err)
+		}
+		if volume == nil {
+			log.Fatalf("Volume %q not found in namespace %q", name, namespace)
+		}
+
+		if printOutput(cmd, volume) {
+			return
+		}
+
+		fmt.Printf("Name:       %s\n", volume.Name)
+		fmt.Printf("State:      %s\n", volume.State)
+		fmt.Printf("Locked:     %t\n", volume.Locked)
+		fmt.Printf("Size:       %s\n", formatGigabytes(volume.Size))
+		fmt.Printf("Usage:      %s (%.0f%%)\n", formatGigabytes(volume.Usage), usagePercentage(*volume))
+
+		mountedBy := volumeMountedBy(*volume)
+		if len(mountedBy) == 0 {
+			fmt.Println("Mounted by: -")
+			return
+		}
+		fmt.Println("Mounted by:")
+		for _, resource := range mountedBy {
+			fmt.Printf("  %s\n", resource)
+		}
+	},
+}
+
+var checkVolumesCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Check volume usage against a threshold, exits non-zero when a volume is above it",
+	Run: func(cmd *cobra.Command, args []string) {
+		namespace, _ := cmd.Flags().GetString("namespace")
+		threshold, _ := cmd.Flags().GetFloat64("threshold")
+		client := api.NewClient()
+
+		namespaces := []string{namespace}
+		if namespace == "" {
+			all, err := client.NamespacesList(cmd.Context())
+			if err != nil {
+				log.Fatalf("Failed to list namespaces: %v", err)
+			}
+			namespaces = make([]string, len(all))
+			for i, ns := range all {
+				namespaces[i] = ns.Name
+			}
+		}
+
+		exceeded := 0
+		for _, ns := range namespaces {
+			volumes, err := client.ListVolumes(cmd.Context(), ns)
+			if err != nil {
+				log.Fatalf("Failed to list volumes in namespace %q: %v", ns, err)
+			}
+
+			for _, volume := range volumes {
+				used := usagePercentage(volume)
+				if used <= threshold {
+					continue
+				}
+				exceeded++
+				fmt.Printf("CRITICAL: volume %s/%s is %.0f%% full (%s of %s)\n", ns, volume.Name, used, formatGigabytes(volume.Usage), formatGigabytes(volume.Size))
+			}
+		}
+
+		if exceeded > 0 {
+			os.Exit(1)
+		}
+		fmt.Printf("OK: no volume is above %.0f%%\n", threshold)
+	},
+}
+
 var createVolumeCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new persistent volume",
@@ -60,7 +207,7 @@ var createVolumeCmd = &cobra.Command{
 		}
 
 		client := api.NewClient()
-		volume, err := client.VolumeCreate(input)
+		volume, err := client.VolumeCreate(cmd.Context(), input)
 		if err != nil {
 			log.Fatalf("Failed to create volume: %v", err)
 			return
@@ -77,22 +224,41 @@ var increaseVolumeCmd = &cobra.Command{
 		namespace, _ := cmd.Flags().GetString("namespace")
 		name, _ := cmd.Flags().GetString("name")
 		size, _ := cmd.Flags().GetInt("size")
+		to, _ := cmd.Flags().GetInt("to")
+		by, _ := cmd.Flags().GetInt("by")
+
+		if size != 0 {
+			to = size
+		}
+
+		client := api.NewClient()
+
+		current, err := client.ListVolumeByName(cmd.Context(), namespace, name)
+		if err != nil {
+			log.Fatalf("Failed to get volume: %s", err)
+		}
+		if current == nil {
+			log.Fatalf("Volume %q not found in namespace %q", name, namespace)
+		}
+
+		newSize, err := volumeNewSize(current.Size, to, by)
+		if err != nil {
+			log.Fatalf("Failed to increase volume: %s", err)
+		}
 
 		input := api.VolumeModifyInput{
 			Namespace: namespace,
 			Name:      name,
-			Size:      size,
+			Size:      newSize,
 		}
 
-		client := api.NewClient()
-
-		volume, err := client.VolumeIncrease(input)
+		volume, err := client.VolumeIncrease(cmd.Context(), input)
 		if err != nil {
 			log.Fatalf("Failed to increase volume: %s", err)
 			return
 		}
 
-		log.Println("increased volume: ", volume.Name)
+		log.Printf("increased volume %s from %s to %dGB", volume.Name, formatGigabytes(current.Size), newSize)
 	},
 }
 
@@ -102,10 +268,60 @@ var deleteVolumeCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		namespace, _ := cmd.Flags().GetString("namespace")
 		name, _ := cmd.Flags().GetString("name")
+		cascade, _ := cmd.Flags().GetBool("cascade")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
 
 		client := api.NewClient()
 
-		result, err := client.VolumeDelete(namespace, name)
+		volume, err := client.ListVolumeByName(cmd.Context(), namespace, name)
+		if err != nil {
+			log.Fatalf("Failed to get volume: %s", err)
+		}
+		if volume == nil {
+			log.Fatalf("Volume %q not found in namespace %q", name, namespace)
+		}
+
+		if !cascade {
+			refuseWithDependents("volume", name, volumeMountedBy(*volume), true)
+		}
+
+		confirmDeletion(cmd, "volume", name)
+
+		if cascade && len(volumeMountedBy(*volume)) > 0 {
+			var containers, jobs []string
+			for _, container := range volume.Containers {
+				if err := unmountFromContainer(cmd.Context(), client, namespace, container.Name, name); err != nil {
+					log.Fatalf("Failed to unmount volume from container %q: %v", container.Name, err)
+				}
+				fmt.Printf("Unmounted volume from container %q.\n", container.Name)
+				containers = append(containers, container.Name)
+			}
+			for _, job := range volume.ContainerJobs {
+				if err := unmountFromContainerJob(cmd.Context(), client, namespace, job.Name, name); err != nil {
+					log.Fatalf("Failed to unmount volume from container job %q: %v", job.Name, err)
+				}
+				fmt.Printf("Unmounted volume from container job %q.\n", job.Name)
+				jobs = append(jobs, job.Name)
+			}
+
+			// The volume can only be removed once the containers and jobs are no longer using it.
+			fmt.Println("Waiting for containers and jobs to release the volume...")
+			if err := waitForSettled(cmd.Context(), client, namespace, containers, jobs, timeout); err != nil {
+				log.Fatalf("Containers and jobs did not release volume %q: %v", name, err)
+			}
+			err = waitUntil(timeout, func() (bool, error) {
+				current, err := client.ListVolumeByName(cmd.Context(), namespace, name)
+				if err != nil {
+					return false, err
+				}
+				return current != nil && len(volumeMountedBy(*current)) == 0, nil
+			})
+			if err != nil {
+				log.Fatalf("Volume %q is still mounted: %v", name, err)
+			}
+		}
+
+		result, err := client.VolumeDelete(cmd.Context(), namespace, name)
 		if err != nil {
 			log.Fatalf("Failed to delete volume: %s", err)
 			return
@@ -122,8 +338,20 @@ var deleteVolumeCmd = &cobra.Command{
 func init() {
 	listVolumesCmd.Flags().StringP("namespace", "n", "", "Namespace")
 	listVolumesCmd.MarkFlagRequired("namespace")
+	addOutputFlag(listVolumesCmd)
 	volumeCmd.AddCommand(listVolumesCmd)
 
+	getVolumeCmd.Flags().StringP("namespace", "n", "", "Namespace")
+	getVolumeCmd.Flags().String("name", "", "Name of the volume")
+	getVolumeCmd.MarkFlagRequired("namespace")
+	getVolumeCmd.MarkFlagRequired("name")
+	addOutputFlag(getVolumeCmd)
+	volumeCmd.AddCommand(getVolumeCmd)
+
+	checkVolumesCmd.Flags().StringP("namespace", "n", "", "Namespace, checks all namespaces when omitted")
+	checkVolumesCmd.Flags().Float64("threshold", 80, "Usage percentage above which a volume is reported")
+	volumeCmd.AddCommand(checkVolumesCmd)
+
 	createVolumeCmd.Flags().StringP("namespace", "n", "", "Namespace")
 	createVolumeCmd.Flags().String("name", "", "Name for the volume")
 	createVolumeCmd.Flags().Int("size", 0, "Size of the volume")
@@ -135,13 +363,21 @@ func init() {
 	increaseVolumeCmd.Flags().StringP("namespace", "n", "", "Namespace")
 	increaseVolumeCmd.Flags().String("name", "", "Name of the volume")
 	increaseVolumeCmd.Flags().Int("size", 0, "Size of the volume")
+	increaseVolumeCmd.Flags().Int("to", 0, "New size of the volume in GB")
+	increaseVolumeCmd.Flags().Int("by", 0, "Number of GB to add to the volume")
+	increaseVolumeCmd.Flags().MarkDeprecated("size", "use --to instead")
+	increaseVolumeCmd.MarkFlagsMutuallyExclusive("size", "to")
+	increaseVolumeCmd.MarkFlagsMutuallyExclusive("size", "by")
+	increaseVolumeCmd.MarkFlagsMutuallyExclusive("to", "by")
 	increaseVolumeCmd.MarkFlagRequired("namespace")
 	increaseVolumeCmd.MarkFlagRequired("name")
-	increaseVolumeCmd.MarkFlagRequired("size")
 	volumeCmd.AddCommand(increaseVolumeCmd)
 
 	deleteVolumeCmd.Flags().StringP("namespace", "n", "", "Namespace")
 	deleteVolumeCmd.Flags().String("name", "", "name")
+	deleteVolumeCmd.Flags().Bool("cascade", false, "Unmount the volume from all containers and jobs before deleting it")
+	deleteVolumeCmd.Flags().Duration("timeout", 10*time.Minute, "Maximum time to wait for containers and jobs to release the volume when using --cascade")
+	addConfirmFlag(deleteVolumeCmd)
 	deleteVolumeCmd.MarkFlagRequired("namespace")
 	deleteVolumeCmd.MarkFlagRequired("name")
 	volumeCmd.AddCommand(deleteVolumeCmd)
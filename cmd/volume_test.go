@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+func TestFormatGigabytes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		size     float64
+		expected string
+	}{
+		{name: "below one gigabyte", size: 0.5, expected: "512 MB"},
+		{name: "zero", size: 0, expected: "0 MB"},
+		{name: "gigabytes", size: 12.345, expected: "12.35 GB"},
+		{name: "terabytes", size: 2048, expected: "2.00 TB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := formatGigabytes(tt.size); got != tt.expected {
+				t.Errorf("formatGigabytes(%v) = %q, expected %q", tt.size, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUsagePercentage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		volume   api.VolumeResult
+		expected float64
+	}{
+		{name: "half full", volume: api.VolumeResult{Size: 10, Usage: 5}, expected: 50},
+		{name: "empty", volume: api.VolumeResult{Size: 10}, expected: 0},
+		{name: "unknown size", volume: api.VolumeResult{Usage: 5}, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := usagePercentage(tt.volume); got != tt.expected {
+				t.Errorf("usagePercentage() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestVolumeNewSize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		current  float64
+		to       int
+		by       int
+		expected int
+		wantErr  bool
+	}{
+		{name: "to a larger size", current: 10, to: 20, expected: 20},
+		{name: "by rounds the current size up", current: 9.6, by: 5, expected: 15},
+		{name: "to the same size", current: 10, to: 10, wantErr: true},
+		{name: "to a smaller size", current: 10, to: 5, wantErr: true},
+		{name: "negative by", current: 10, by: -1, wantErr: true},
+		{name: "neither to nor by", current: 10, wantErr: true},
+		{name: "both to and by", current: 10, to: 20, by: 5, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := volumeNewSize(tt.current, tt.to, tt.by)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("volumeNewSize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("volumeNewSize() = %d, expected %d", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,255 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+const (
+	ansiHighlight  = "\033[1;33m"
+	ansiReset      = "\033[0m"
+	ansiClearLine  = "\033[2K"
+	ansiClearDown  = "\033[J"
+	ansiHideCursor = "\033[?25l"
+	ansiShowCursor = "\033[?25h"
+	// watchEventLines is the number of recent events shown below the table in a terminal.
+	watchEventLines = 10
+)
+
+// transition is a change of a single resource between two polls.
+type transition struct {
+	Resource string
+	Field    string
+	From     string
+	To       string
+}
+
+func (t transition) String() string {
+	switch t.Field {
+	case "appeared":
+		return fmt.Sprintf("%s appeared (%s)", t.Resource, t.To)
+	case "disappeared":
+		return fmt.Sprintf("%s disappeared", t.Resource)
+	}
+	return fmt.Sprintf("%s %s %s → %s", t.Resource, t.Field, t.From, t.To)
+}
+
+func resourceKey(resource resourceStatus) string {
+	return resource.Kind + "/" + resource.Name
+}
+
+// resourceTransitions lists the changes in state, lock, replicas and job runs between two polls.
+func resourceTransitions(previous []resourceStatus, current []resourceStatus) []transition {
+	before := map[string]resourceStatus{}
+	for _, resource := range previous {
+		before[resourceKey(resource)] = resource
+	}
+
+	var transitions []transition
+	seen := map[string]bool{}
+	for _, resource := range current {
+		key := resourceKey(resource)
+		seen[key] = true
+
+		old, ok := before[key]
+		if !ok {
+			transitions = append(transitions, transition{Resource: key, Field: "appeared", To: resource.State})
+			continue
+		}
+		if old.State != resource.State {
+			transitions = append(transitions, transition{Resource: key, Field: "state", From: old.State, To: resource.State})
+		}
+		if old.Locked != resource.Locked {
+			transitions = append(transitions, transition{Resource: key, Field: "locked", From: enabledApiToString(old.Locked), To: enabledApiToString(resource.Locked)})
+		}
+		// Volume usage changes all the time, only replica and job run changes are transitions.
+		if old.Details != resource.Details && resource.Kind != "volume" {
+			transitions = append(transitions, transition{Resource: key, Field: "status", From: old.Details, To: resource.Details})
+		}
+	}
+
+	for _, resource := range previous {
+		if key := resourceKey(resource); !seen[key] {
+			transitions = append(transitions, transition{Resource: key, Field: "disappeared", From: resource.State})
+		}
+	}
+
+	return transitions
+}
+
+func filterResources(resources []resourceStatus, kind string, names []string) []resourceStatus {
+	var filtered []resourceStatus
+	for _, resource := range resources {
+		if kind != "" && resource.Kind != kind {
+			continue
+		}
+		if len(names) > 0 && !slices.Contains(names, resource.Name) {
+			continue
+		}
+		filtered = append(filtered, resource)
+	}
+	return filtered
+}
+
+func watchTableLines(resources []resourceStatus) []string {
+	var buffer bytes.Buffer
+	writer := tabwriter.NewWriter(&buffer, 0, 0, 3, ' ', tabwriter.Debug)
+	fmt.Fprintln(writer, "KIND\t NAME\t STATE\t LOCKED\t DETAILS\t")
+	for _, resource := range resources {
+		fmt.Fprintf(writer, "%s\t %s\t %s\t %s\t %s\t\n", resource.Kind, resource.Name, resource.State, enabledApiToString(resource.Locked), resource.Details)
+	}
+	writer.Flush()
+	return strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+}
+
+// watchScreen keeps the last drawn lines so that only changed lines are written to the terminal.
+type watchScreen struct {
+	out         io.Writer
+	lines       []string
+	highlighted map[int]bool
+}
+
+// draw writes the lines that differ from the previous frame. Lines that changed since
+// the previous frame are highlighted until the next one.
+func (screen *watchScreen) draw(lines []string, highlight bool) {
+	highlighted := map[int]bool{}
+	for i, line := range lines {
+		changed := i >= len(screen.lines) || screen.lines[i] != line
+		if !changed && !screen.highlighted[i] {
+			continue
+		}
+
+		fmt.Fprintf(screen.out, "\033[%d;1H%s", i+1, ansiClearLine)
+		if changed && highlight {
+			fmt.Fprint(screen.out, ansiHighlight+line+ansiReset)
+			highlighted[i] = true
+		} else {
+			fmt.Fprint(screen.out, line)
+		}
+	}
+
+	if len(lines) < len(screen.lines) {
+		fmt.Fprintf(screen.out, "\033[%d;1H%s", len(lines)+1, ansiClearDown)
+	}
+
+	screen.lines = lines
+	screen.highlighted = highlighted
+}
+
+var watchCmd = &cobra.Command{
+	Use:   "watch [NAME...]",
+	Short: "Watch the state of the resources in a namespace",
+	Long: `Polls the resources in a namespace and shows state transitions as they happen.
+
+In a terminal the table is updated in place, changed rows are highlighted and the most
+recent events are shown below it. When the output is not a terminal only the timestamped
+event lines are printed, so the output can be logged.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		namespace, _ := cmd.Flags().GetString("namespace")
+		interval, _ := cmd.Flags().GetDuration("interval")
+		kind, _ := cmd.Flags().GetString("kind")
+		eventsOnly, _ := cmd.Flags().GetBool("events")
+
+		if interval <= 0 {
+			log.Fatalf("--interval must be positive")
+		}
+
+		interactive := !eventsOnly && term.IsTerminal(int(os.Stdout.Fd()))
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+		defer stop()
+
+		client := api.NewClient()
+		poll := func() ([]resourceStatus, error) {
+			description, err := client.NamespaceDescribe(ctx, namespace)
+			if err != nil {
+				return nil, err
+			}
+			return filterResources(summariseNamespace(description).Resources, kind, args), nil
+		}
+
+		resources, err := poll()
+		if err != nil {
+			log.Fatalf("Failed to describe namespace: %v", err)
+		}
+
+		screen := &watchScreen{out: os.Stdout}
+		var events []string
+		event := func(line string) {
+			line = time.Now().Format("15:04:05") + " " + line
+			if !interactive {
+				fmt.Println(line)
+				return
+			}
+			events = append(events, line)
+			if len(events) > watchEventLines {
+				events = events[len(events)-watchEventLines:]
+			}
+		}
+		frame := func(highlight bool) {
+			lines := []string{fmt.Sprintf("Every %s: namespace %s (Ctrl-C to stop)", interval, namespace), ""}
+			lines = append(lines, watchTableLines(resources)...)
+			lines = append(lines, "", "EVENTS")
+			lines = append(lines, events...)
+			screen.draw(lines, highlight)
+		}
+
+		if interactive {
+			fmt.Print("\033[H\033[2J" + ansiHideCursor)
+			defer fmt.Print(ansiShowCursor + "\n")
+			frame(false)
+		} else {
+			for _, resource := range resources {
+				event(fmt.Sprintf("%s %s", resourceKey(resource), resource.State))
+			}
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			current, err := poll()
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				event(fmt.Sprintf("error: %v", err))
+			} else {
+				for _, change := range resourceTransitions(resources, current) {
+					event(change.String())
+				}
+				resources = current
+			}
+
+			if interactive {
+				frame(true)
+			}
+		}
+	},
+}
+
+func init() {
+	watchCmd.Flags().StringP("namespace", "n", "", "Namespace")
+	watchCmd.Flags().Duration("interval", 5*time.Second, "Time between polls")
+	watchCmd.Flags().String("kind", "", "Only watch resources of this kind (container, job, volume, database-cluster, message-queue or registry)")
+	watchCmd.Flags().Bool("events", false, "Only print event lines, also in a terminal")
+	watchCmd.MarkFlagRequired("namespace")
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestResourceTransitions(t *testing.T) {
+	t.Parallel()
+
+	previous := []resourceStatus{
+		{Kind: "container", Name: "web", State: "CREATING", Details: "0/2 replicas"},
+		{Kind: "container", Name: "api", State: "RUNNING", Details: "2/2 replicas"},
+		{Kind: "volume", Name: "data", State: "ACTIVE", Details: "1.00 GB of 10.00 GB (10%)"},
+		{Kind: "job", Name: "old", State: "ACTIVE"},
+	}
+	current := []resourceStatus{
+		{Kind: "container", Name: "web", State: "CREATED", Details: "2/2 replicas"},
+		{Kind: "container", Name: "api", State: "RUNNING", Locked: true, Details: "1/2 replicas"},
+		{Kind: "volume", Name: "data", State: "ACTIVE", Details: "1.10 GB of 10.00 GB (11%)"},
+		{Kind: "registry", Name: "gitlab", State: "ACTIVE"},
+	}
+
+	var got []string
+	for _, change := range resourceTransitions(previous, current) {
+		got = append(got, change.String())
+	}
+
+	expected := []string{
+		"container/web state CREATING → CREATED",
+		"container/web status 0/2 replicas → 2/2 replicas",
+		"container/api locked False → True",
+		"container/api status 2/2 replicas → 1/2 replicas",
+		"registry/gitlab appeared (ACTIVE)",
+		"job/old disappeared",
+	}
+	if !slices.Equal(got, expected) {
+		t.Errorf("resourceTransitions() = %q, expected %q", got, expected)
+	}
+}
+
+func TestWatchScreenDrawsOnlyChangedLines(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	screen := &watchScreen{out: &out}
+
+	screen.draw([]string{"header", "web RUNNING", "api RUNNING"}, false)
+	out.Reset()
+
+	screen.draw([]string{"header", "web RUNNING", "api FAILED"}, true)
+	if got := out.String(); strings.Contains(got, "web") || !strings.Contains(got, "\033[3;1H"+ansiClearLine+ansiHighlight+"api FAILED") {
+		t.Errorf("draw() after a change wrote %q", got)
+	}
+	out.Reset()
+
+	screen.draw([]string{"header", "web RUNNING", "api FAILED"}, true)
+	if got := out.String(); got != "\033[3;1H"+ansiClearLine+"api FAILED" {
+		t.Errorf("draw() without changes wrote %q, expected only the highlight to be removed", got)
+	}
+	out.Reset()
+
+	screen.draw([]string{"header"}, true)
+	if got := out.String(); got != "\033[2;1H"+ansiClearDown {
+		t.Errorf("draw() with fewer lines wrote %q", got)
+	}
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"path/filepath"
 
 	"github.com/joho/godotenv"
 )
@@ -12,6 +13,7 @@ var (
 	KEYCLOAK_CLIENT_ID string
 	KEYCLOAK_REALM     string
 	TOKEN_FILE         string
+	COMPLETION_CACHE   string
 )
 
 // Initialize sets up the environment configuration using individual environment variables
@@ -26,6 +28,16 @@ func Initialize() {
 	KEYCLOAK_CLIENT_ID = "cloud-tilaa"
 	KEYCLOAK_REALM = "tilaa"
 	TOKEN_FILE = getEnvWithDefault("NEXAA_TOKEN_FILE", "./auth.json")
+	COMPLETION_CACHE = getEnvWithDefault("NEXAA_COMPLETION_CACHE", defaultCompletionCache())
+}
+
+// defaultCompletionCache returns the file in the user cache directory where shell completion results are kept
+func defaultCompletionCache() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "nexaa", "completion.json")
 }
 
 // getEnvWithDefault returns the environment variable value or the default if not set
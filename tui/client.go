@@ -0,0 +1,25 @@
+// Package tui implements the interactive terminal UI started by `nexaa tui`.
+package tui
+
+import (
+	"context"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+// Client is the part of api.Client used by the terminal UI, tests use the in-memory api/fake client.
+type Client interface {
+	NamespacesList(ctx context.Context) ([]api.NamespaceResult, error)
+	NamespaceDescribe(ctx context.Context, name string) (api.NamespaceDescription, error)
+	ListContainerByName(ctx context.Context, namespace string, containerName string) (api.ContainerResult, error)
+	ContainerModify(ctx context.Context, input api.ContainerModifyInput) (api.ContainerResult, error)
+	ContainerDelete(ctx context.Context, namespace string, containerName string) (bool, error)
+	ContainerJobByName(ctx context.Context, namespace string, name string) (api.ContainerJobResult, error)
+	ContainerJobDelete(ctx context.Context, namespace string, containerJobName string) (bool, error)
+	VolumeDelete(ctx context.Context, namespace string, volumeName string) (bool, error)
+	CloudDatabaseClusterDelete(ctx context.Context, input api.CloudDatabaseClusterResourceInput) (bool, error)
+	MessageQueueDelete(ctx context.Context, input api.MessageQueueResourceInput) (bool, error)
+	RegistryDelete(ctx context.Context, namespace string, registryName string) (bool, error)
+}
+
+var _ Client = (*api.Client)(nil)
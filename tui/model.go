@@ -0,0 +1,610 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+type pane int
+
+const (
+	namespacesPane pane = iota
+	resourcesPane
+)
+
+type mode int
+
+const (
+	browseMode mode = iota
+	detailMode
+	inputMode
+)
+
+// namespacePaneWidth is the width of the left pane, including the separator.
+const namespacePaneWidth = 26
+
+const helpLine = "↑↓ move  tab switch  enter/d describe  e env  c connections  r runs  s scale  x delete  R refresh  q quit"
+
+// update applies the result of a request made in the background to the model.
+type update func(m *Model)
+
+// Model holds the state of the terminal UI. Key presses are handled by HandleKey and the
+// screen is rendered by View, which keeps the UI testable without a terminal.
+//
+// Requests to the API are made in the background, so the UI keeps responding while they run. Their
+// results are sent as updates on a channel and applied by the goroutine that handles the keys.
+type Model struct {
+	ctx        context.Context
+	client     Client
+	dependents DependentsFunc
+
+	updates    chan update
+	pending    int
+	refreshing bool
+
+	namespaces     []string
+	namespaceIndex int
+	namespace      string
+	open           string
+
+	resources     []resource
+	resourceIndex int
+
+	focus pane
+	mode  mode
+
+	detailTitle string
+	detail      []string
+
+	prompt string
+	input  string
+	submit func(input string)
+
+	status  string
+	updated time.Time
+	now     func() time.Time
+}
+
+// NewModel creates a model that makes its requests with ctx, call Refresh to load the namespaces.
+// Resources for which dependents returns anything are not deleted, a nil dependents skips the check.
+func NewModel(ctx context.Context, client Client, dependents DependentsFunc) *Model {
+	if dependents == nil {
+		dependents = func(context.Context, string, string, string) ([]string, error) { return nil, nil }
+	}
+	return &Model{ctx: ctx, client: client, dependents: dependents, updates: make(chan update), now: time.Now}
+}
+
+// fetch runs request in the background, the update it returns is applied by apply.
+// The request must not read the model, only the values captured before fetch is called.
+func (m *Model) fetch(request func() update) {
+	m.pending++
+	go func() {
+		result := request()
+		select {
+		case m.updates <- result:
+		case <-m.ctx.Done():
+		}
+	}()
+}
+
+func (m *Model) apply(result update) {
+	m.pending--
+	result(m)
+}
+
+// SelectNamespace opens the namespace with the given name when it exists. Before the namespaces
+// are loaded, the namespace is opened once they are.
+func (m *Model) SelectNamespace(name string) {
+	if m.namespaces == nil {
+		m.open = name
+		return
+	}
+
+	for i, namespace := range m.namespaces {
+		if namespace == name {
+			m.namespaceIndex = i
+			m.openNamespace()
+			return
+		}
+	}
+	m.status = fmt.Sprintf("Namespace %q not found.", name)
+}
+
+// Refresh reloads the namespaces and the resources of the open namespace.
+func (m *Model) Refresh() {
+	m.refresh("")
+}
+
+// refresh reloads in the background and shows status when it succeeds, a refresh that is still
+// running is not repeated.
+func (m *Model) refresh(status string) {
+	if m.refreshing {
+		return
+	}
+	m.refreshing = true
+
+	namespace := m.namespace
+	m.fetch(func() update {
+		namespaces, err := m.client.NamespacesList(m.ctx)
+		if err != nil {
+			return func(m *Model) {
+				m.refreshing = false
+				m.status = fmt.Sprintf("Failed to list namespaces: %v", err)
+			}
+		}
+
+		var description api.NamespaceDescription
+		if namespace != "" {
+			description, err = m.client.NamespaceDescribe(m.ctx, namespace)
+		}
+
+		return func(m *Model) {
+			m.refreshing = false
+			m.namespaces = make([]string, 0, len(namespaces))
+			for _, namespace := range namespaces {
+				m.namespaces = append(m.namespaces, namespace.Name)
+			}
+			m.namespaceIndex = clamp(m.namespaceIndex, len(m.namespaces))
+			m.updated = m.now()
+
+			if namespace != "" && namespace == m.namespace {
+				m.showResources(namespace, description, err)
+			}
+			if status != "" && err == nil {
+				m.status = status
+			}
+			if m.open != "" {
+				name := m.open
+				m.open = ""
+				m.SelectNamespace(name)
+			}
+		}
+	})
+}
+
+// loadResources reloads the resources of the open namespace in the background.
+func (m *Model) loadResources() {
+	namespace := m.namespace
+	m.fetch(func() update {
+		description, err := m.client.NamespaceDescribe(m.ctx, namespace)
+		return func(m *Model) {
+			if namespace == m.namespace {
+				m.showResources(namespace, description, err)
+			}
+		}
+	})
+}
+
+func (m *Model) showResources(namespace string, description api.NamespaceDescription, err error) {
+	if err != nil {
+		m.status = fmt.Sprintf("Failed to load namespace %q: %v", namespace, err)
+		return
+	}
+
+	m.resources = resourceRows(description)
+	m.resourceIndex = clamp(m.resourceIndex, len(m.resources))
+}
+
+func (m *Model) openNamespace() {
+	if len(m.namespaces) == 0 {
+		return
+	}
+
+	m.namespace = m.namespaces[m.namespaceIndex]
+	m.resources = nil
+	m.resourceIndex = 0
+	m.loadResources()
+	m.focus = resourcesPane
+}
+
+func (m *Model) selected() (resource, bool) {
+	if m.focus != resourcesPane || len(m.resources) == 0 {
+		return resource{}, false
+	}
+	return m.resources[m.resourceIndex], true
+}
+
+func (m *Model) showDetail(title string, lines []string) {
+	m.mode = detailMode
+	m.detailTitle = title
+	m.detail = lines
+}
+
+func (m *Model) ask(prompt string, submit func(input string)) {
+	m.mode = inputMode
+	m.prompt = prompt
+	m.input = ""
+	m.submit = submit
+}
+
+// HandleKey processes a key press and reports whether the UI should quit.
+func (m *Model) HandleKey(key string) bool {
+	switch m.mode {
+	case inputMode:
+		m.handleInput(key)
+		return false
+	case detailMode:
+		switch key {
+		case "esc", "q", "enter", "left", "h":
+			m.mode = browseMode
+		case "ctrl+c":
+			return true
+		}
+		return false
+	}
+
+	switch key {
+	case "q", "ctrl+c":
+		return true
+	case "up", "k":
+		m.move(-1)
+	case "down", "j":
+		m.move(1)
+	case "tab":
+		if m.focus == namespacesPane && m.namespace != "" {
+			m.focus = resourcesPane
+		} else {
+			m.focus = namespacesPane
+		}
+	case "left", "h", "esc":
+		m.focus = namespacesPane
+	case "right", "l":
+		if m.focus == namespacesPane {
+			m.openNamespace()
+		}
+	case "enter":
+		if m.focus == namespacesPane {
+			m.openNamespace()
+		} else {
+			m.describe()
+		}
+	case "d":
+		m.describe()
+	case "e":
+		m.environment()
+	case "c":
+		m.connections()
+	case "r":
+		m.runs()
+	case "s":
+		m.scale()
+	case "x":
+		m.delete()
+	case "R":
+		m.refresh("Refreshed.")
+	}
+	return false
+}
+
+func (m *Model) handleInput(key string) {
+	switch key {
+	case "esc", "ctrl+c":
+		m.mode = browseMode
+		m.status = "Cancelled."
+	case "enter":
+		m.mode = browseMode
+		m.submit(m.input)
+	case "backspace":
+		if m.input != "" {
+			m.input = m.input[:len(m.input)-1]
+		}
+	default:
+		if len(key) == 1 {
+			m.input += key
+		}
+	}
+}
+
+func (m *Model) move(delta int) {
+	if m.focus == namespacesPane {
+		m.namespaceIndex = clamp(m.namespaceIndex+delta, len(m.namespaces))
+		return
+	}
+	m.resourceIndex = clamp(m.resourceIndex+delta, len(m.resources))
+}
+
+// showContainer fetches the container in the background and shows the lines render returns for it.
+func (m *Model) showContainer(name string, title string, render func(api.ContainerResult) []string) {
+	namespace := m.namespace
+	m.fetch(func() update {
+		container, err := m.client.ListContainerByName(m.ctx, namespace, name)
+		return func(m *Model) {
+			if err != nil {
+				m.status = fmt.Sprintf("Failed to get container: %v", err)
+				return
+			}
+			m.showDetail(title, render(container))
+		}
+	})
+}
+
+// showContainerJob fetches the container job in the background and shows the lines render returns for it.
+func (m *Model) showContainerJob(name string, title string, render func(api.ContainerJobResult) []string) {
+	namespace := m.namespace
+	m.fetch(func() update {
+		job, err := m.client.ContainerJobByName(m.ctx, namespace, name)
+		return func(m *Model) {
+			if err != nil {
+				m.status = fmt.Sprintf("Failed to get container job: %v", err)
+				return
+			}
+			m.showDetail(title, render(job))
+		}
+	})
+}
+
+func (m *Model) describe() {
+	row, ok := m.selected()
+	if !ok {
+		return
+	}
+
+	title := fmt.Sprintf("%s %s", row.kind, row.name)
+	switch row.kind {
+	case KindContainer:
+		m.showContainer(row.name, title, describeContainer)
+	case KindJob:
+		m.showContainerJob(row.name, title, describeContainerJob)
+	default:
+		m.showDetail(title, []string{
+			"State:      " + row.state,
+			fmt.Sprintf("Locked:     %t", row.locked),
+			"Details:    " + row.details,
+		})
+	}
+}
+
+func (m *Model) environment() {
+	row, ok := m.selected()
+	if !ok {
+		return
+	}
+
+	title := fmt.Sprintf("environment of %s %s", row.kind, row.name)
+	switch row.kind {
+	case KindContainer:
+		m.showContainer(row.name, title, func(container api.ContainerResult) []string {
+			return environmentLines(container.EnvironmentVariables)
+		})
+	case KindJob:
+		m.showContainerJob(row.name, title, func(job api.ContainerJobResult) []string {
+			return environmentLines(job.EnvironmentVariables)
+		})
+	default:
+		m.status = "Only containers and jobs have environment variables."
+	}
+}
+
+func (m *Model) connections() {
+	row, ok := m.selected()
+	if !ok {
+		return
+	}
+	if row.kind != KindContainer {
+		m.status = "Only containers have external connections."
+		return
+	}
+
+	m.showContainer(row.name, "external connections of container "+row.name, connectionLines)
+}
+
+func (m *Model) runs() {
+	row, ok := m.selected()
+	if !ok {
+		return
+	}
+	if row.kind != KindJob {
+		m.status = "Only jobs have runs."
+		return
+	}
+	m.showDetail("runs of job "+row.name, runLines(row.runs))
+}
+
+func (m *Model) scale() {
+	row, ok := m.selected()
+	if !ok {
+		return
+	}
+	if row.kind != KindContainer {
+		m.status = "Only containers can be scaled."
+		return
+	}
+
+	namespace := m.namespace
+	m.ask(fmt.Sprintf("Replicas for container %s: ", row.name), func(input string) {
+		replicas, err := strconv.Atoi(strings.TrimSpace(input))
+		if err != nil || replicas < 0 {
+			m.status = fmt.Sprintf("Invalid number of replicas %q.", input)
+			return
+		}
+
+		m.status = fmt.Sprintf("Scaling container %q...", row.name)
+		m.fetch(func() update {
+			err := scaleContainer(m.ctx, m.client, namespace, row.name, replicas)
+			return func(m *Model) {
+				if err != nil {
+					m.status = fmt.Sprintf("Failed to scale container %q: %v", row.name, err)
+					return
+				}
+				m.status = fmt.Sprintf("Scaled container %q to %d replicas.", row.name, replicas)
+				m.loadResources()
+			}
+		})
+	})
+}
+
+// delete refuses to delete a resource that other resources still use, like the delete commands do,
+// and asks to type the name of the resource otherwise.
+func (m *Model) delete() {
+	row, ok := m.selected()
+	if !ok {
+		return
+	}
+
+	namespace := m.namespace
+	m.status = fmt.Sprintf("Checking whether %s %q is in use...", row.kind, row.name)
+	m.fetch(func() update {
+		dependents, err := m.dependents(m.ctx, namespace, row.kind, row.name)
+		return func(m *Model) {
+			if err != nil {
+				m.status = fmt.Sprintf("Failed to check whether %s %q is in use: %v", row.kind, row.name, err)
+				return
+			}
+			if len(dependents) > 0 {
+				m.status = fmt.Sprintf("Cannot delete %s %q, it is still used by %s.", row.kind, row.name, strings.Join(dependents, ", "))
+				return
+			}
+
+			m.status = ""
+			m.ask(fmt.Sprintf("Type the name of %s %q in namespace %q to delete it: ", row.kind, row.name, namespace), func(input string) {
+				if input != row.name {
+					m.status = fmt.Sprintf("Confirmation did not match, %s %q was not deleted.", row.kind, row.name)
+					return
+				}
+				m.confirmDelete(namespace, row)
+			})
+		}
+	})
+}
+
+func (m *Model) confirmDelete(namespace string, row resource) {
+	m.status = fmt.Sprintf("Deleting %s %q...", row.kind, row.name)
+	m.fetch(func() update {
+		err := deleteResource(m.ctx, m.client, namespace, row)
+		return func(m *Model) {
+			if err != nil {
+				m.status = fmt.Sprintf("Failed to delete %s %q: %v", row.kind, row.name, err)
+				return
+			}
+			m.status = fmt.Sprintf("Deleted %s %q.", row.kind, row.name)
+			m.loadResources()
+		}
+	})
+}
+
+// View renders the screen as lines of at most width characters.
+func (m *Model) View(width int, height int) []string {
+	header := "nexaa"
+	if m.namespace != "" {
+		header += "  namespace: " + m.namespace
+	}
+	if !m.updated.IsZero() {
+		header += "  updated " + m.updated.Format("15:04:05")
+	}
+	if m.pending > 0 {
+		header += "  loading..."
+	}
+
+	bodyHeight := max(height-3, 1)
+	left := m.namespaceLines(bodyHeight)
+	right := m.resourceLines(bodyHeight)
+
+	lines := []string{fit(header, width)}
+	for i := 0; i < bodyHeight; i++ {
+		line := pad(at(left, i), namespacePaneWidth-2) + "│ " + at(right, i)
+		lines = append(lines, fit(line, width))
+	}
+
+	footer := m.status
+	if m.mode == inputMode {
+		footer = m.prompt + m.input
+	}
+	lines = append(lines, fit(footer, width), fit(helpLine, width))
+	return lines
+}
+
+func (m *Model) namespaceLines(height int) []string {
+	lines := []string{"NAMESPACES"}
+	start, visible := window(m.namespaces, m.namespaceIndex, height-1)
+	for i, namespace := range visible {
+		lines = append(lines, cursor(m.focus == namespacesPane && start+i == m.namespaceIndex, namespace == m.namespace)+namespace)
+	}
+	return lines
+}
+
+func (m *Model) resourceLines(height int) []string {
+	if m.mode == detailMode {
+		return append([]string{strings.ToUpper(m.detailTitle) + "  (esc to go back)"}, m.detail...)
+	}
+	if m.namespace == "" {
+		return []string{"Select a namespace and press enter."}
+	}
+	if len(m.resources) == 0 && m.pending > 0 {
+		return []string{"Loading..."}
+	}
+	if len(m.resources) == 0 {
+		return []string{"No resources in this namespace."}
+	}
+
+	rows := make([]string, len(m.resources))
+	for i, row := range m.resources {
+		locked := ""
+		if row.locked {
+			locked = "locked"
+		}
+		rows[i] = fmt.Sprintf("%-17s %-24s %-16s %-7s %s", row.kind, row.name, row.state, locked, row.details)
+	}
+
+	lines := []string{fmt.Sprintf("  %-17s %-24s %-16s %-7s %s", "KIND", "NAME", "STATE", "LOCKED", "DETAILS")}
+	start, visible := window(rows, m.resourceIndex, height-1)
+	for i, row := range visible {
+		lines = append(lines, cursor(m.focus == resourcesPane && start+i == m.resourceIndex, false)+row)
+	}
+	return lines
+}
+
+// window returns the items that fit in height lines while keeping the selected item visible,
+// together with the index of the first visible item.
+func window(items []string, selected int, height int) (int, []string) {
+	start := 0
+	if selected >= height {
+		start = selected - height + 1
+	}
+	end := min(start+max(height, 0), len(items))
+	if start > end {
+		return 0, nil
+	}
+	return start, items[start:end]
+}
+
+func cursor(selected bool, open bool) string {
+	switch {
+	case selected:
+		return "> "
+	case open:
+		return "* "
+	}
+	return "  "
+}
+
+func clamp(index int, length int) int {
+	if index >= length {
+		index = length - 1
+	}
+	return max(index, 0)
+}
+
+func at(lines []string, index int) string {
+	if index < len(lines) {
+		return lines[index]
+	}
+	return ""
+}
+
+func pad(line string, width int) string {
+	line = fit(line, width)
+	return line + strings.Repeat(" ", width-len([]rune(line)))
+}
+
+func fit(line string, width int) string {
+	runes := []rune(line)
+	if len(runes) > width {
+		return string(runes[:max(width, 0)])
+	}
+	return line
+}
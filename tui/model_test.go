@@ -0,0 +1,266 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+	"github.com/nexaa-cloud/nexaa-cli/api/fake"
+)
+
+// newTestClient returns a fake with a production namespace holding a container, a job, a volume and
+// a registry, and an empty staging namespace.
+func newTestClient() *fake.Client {
+	client := fake.New()
+	client.AddNamespace(api.NamespaceResult{Name: "production", Description: "Production"})
+	client.AddNamespace(api.NamespaceResult{Name: "staging", Description: "Staging"})
+
+	debug := "false"
+	internalPort := 80
+	client.AddContainer("production", api.ContainerResult{
+		Name:            "web",
+		Image:           "nginx:latest",
+		PrivateRegistry: &api.ContainerResultPrivateRegistry{Name: "gitlab"},
+		Resources:       api.ContainerResourcesCpu250Ram500,
+		EnvironmentVariables: []api.EnvironmentVariableResult{
+			{Name: "DEBUG", Value: &debug},
+			{Name: "PASSWORD", Secret: true},
+		},
+		ExternalConnection: &api.ContainerResultExternalConnection{ExternalConnectionResult: api.ExternalConnectionResult{
+			Ipv4: "192.0.2.10",
+			Ipv6: "2001:db8::10",
+			Ports: []api.ExternalConnectionResultPortsExternalConnectionPort{
+				{AllowList: []string{"0.0.0.0/0"}, ExternalPort: 30080, InternalPort: &internalPort, Protocol: api.ProtocolTcp},
+			},
+		}},
+		Ports:             []string{"80"},
+		AvailableReplicas: 1,
+		NumberOfReplicas:  2,
+		State:             fake.StateCreated,
+	})
+
+	client.AddContainerJob(api.ContainerJobResult{
+		Name:      "backup",
+		Image:     "backup:latest",
+		Namespace: api.ContainerJobResultNamespace{Name: "production"},
+		Resources: api.ContainerResourcesCpu250Ram500,
+		Command:   []string{"backup.sh"},
+		Schedule:  "0 3 * * *",
+		Enabled:   true,
+		State:     fake.StateCreated,
+	})
+	succeeded := time.Date(2026, 10, 17, 3, 0, 0, 0, time.UTC)
+	failed := time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC)
+	client.SetContainerJobRuns("production", "backup", []api.NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun{
+		{Name: "backup-1", Status: "SUCCEEDED", StartTime: &succeeded},
+		{Name: "backup-2", Status: "FAILED", StartTime: &failed},
+	})
+
+	client.AddVolume("production", api.VolumeResult{Name: "data", State: fake.StateCreated, Locked: true, Size: 10, Usage: 2.5})
+	client.AddRegistry("production", api.RegistryResult{Name: "gitlab", State: fake.StateCreated})
+	return client
+}
+
+// testDependents reports the gitlab registry as used by the web container.
+func testDependents(ctx context.Context, namespace string, kind string, name string) ([]string, error) {
+	if kind == KindRegistry && name == "gitlab" {
+		return []string{"container/web"}, nil
+	}
+	return nil, nil
+}
+
+func newTestModel(t *testing.T) (*Model, *fake.Client) {
+	t.Helper()
+
+	client := newTestClient()
+	model := NewModel(t.Context(), client, testDependents)
+	model.now = func() time.Time { return time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC) }
+	model.Refresh()
+	settle(model)
+	return model, client
+}
+
+// settle applies the results of the requests running in the background.
+func settle(model *Model) {
+	for model.pending > 0 {
+		model.apply(<-model.updates)
+	}
+}
+
+// press handles the keys one by one, waiting for the requests each key starts.
+func press(model *Model, keys ...string) {
+	for _, key := range keys {
+		model.HandleKey(key)
+		settle(model)
+	}
+}
+
+func typeText(model *Model, text string) {
+	for _, r := range text {
+		press(model, string(r))
+	}
+}
+
+func screen(model *Model) string {
+	return strings.Join(model.View(160, 20), "\n")
+}
+
+func TestBrowseNamespaces(t *testing.T) {
+	t.Parallel()
+
+	model, _ := newTestModel(t)
+
+	if view := screen(model); !strings.Contains(view, "> production") || !strings.Contains(view, "Select a namespace") {
+		t.Fatalf("initial view:\n%s", view)
+	}
+
+	press(model, "enter")
+	view := screen(model)
+	for _, expected := range []string{"namespace: production", "web", "1/2 replicas", "last run failed", "locked", "gitlab"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("view of production does not contain %q:\n%s", expected, view)
+		}
+	}
+
+	press(model, "tab", "down", "enter")
+	if view := screen(model); !strings.Contains(view, "namespace: staging") || !strings.Contains(view, "No resources") {
+		t.Errorf("view of staging:\n%s", view)
+	}
+}
+
+func TestDetailViews(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		keys     []string
+		expected []string
+	}{
+		{name: "describe container", keys: []string{"enter", "d"}, expected: []string{"nginx:latest", "1/2 available", "gitlab"}},
+		{name: "container environment", keys: []string{"enter", "e"}, expected: []string{"DEBUG=false", "PASSWORD=********"}},
+		{name: "external connections", keys: []string{"enter", "c"}, expected: []string{"192.0.2.10", "30080 -> 80 TCP"}},
+		{name: "job runs newest first", keys: []string{"enter", "down", "r"}, expected: []string{"FAILED       backup-2"}},
+		{name: "describe job", keys: []string{"enter", "down", "enter"}, expected: []string{"backup:latest", "0 3 * * *"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			model, _ := newTestModel(t)
+			press(model, tt.keys...)
+
+			view := screen(model)
+			for _, expected := range tt.expected {
+				if !strings.Contains(view, expected) {
+					t.Errorf("view does not contain %q:\n%s", expected, view)
+				}
+			}
+
+			press(model, "esc")
+			if model.mode != browseMode {
+				t.Errorf("esc did not close the detail view")
+			}
+		})
+	}
+}
+
+func TestDeleteAsksForTheName(t *testing.T) {
+	t.Parallel()
+
+	model, client := newTestModel(t)
+	press(model, "enter", "down", "down", "x")
+
+	if view := screen(model); !strings.Contains(view, `Type the name of volume "data" in namespace "production" to delete it:`) {
+		t.Fatalf("delete prompt not shown:\n%s", view)
+	}
+
+	press(model, "y", "enter")
+	if _, err := client.ListVolumeByName(t.Context(), "production", "data"); err != nil {
+		t.Fatalf("volume deleted without typing its name: %v", err)
+	}
+	if !strings.Contains(model.status, "Confirmation did not match") {
+		t.Errorf("status %q after a wrong name", model.status)
+	}
+
+	press(model, "x")
+	typeText(model, "data")
+	press(model, "enter")
+	if _, err := client.ListVolumeByName(t.Context(), "production", "data"); !errors.Is(err, fake.ErrNotFound) {
+		t.Errorf("volume not deleted, status %q", model.status)
+	}
+	if view := screen(model); strings.Contains(view, "volume            data") {
+		t.Errorf("deleted volume still shown:\n%s", view)
+	}
+}
+
+func TestDeleteRefusesResourcesInUse(t *testing.T) {
+	t.Parallel()
+
+	model, client := newTestModel(t)
+	press(model, "enter", "down", "down", "down", "x")
+
+	if model.mode != browseMode || !strings.Contains(model.status, `Cannot delete registry "gitlab", it is still used by container/web.`) {
+		t.Fatalf("delete of a registry in use was not refused, status %q", model.status)
+	}
+	if _, err := client.ListRegistryByName(t.Context(), "production", "gitlab"); err != nil {
+		t.Errorf("registry in use was deleted: %v", err)
+	}
+}
+
+func TestRequestsRunInTheBackground(t *testing.T) {
+	t.Parallel()
+
+	model := NewModel(t.Context(), newTestClient(), nil)
+	model.SelectNamespace("production")
+	model.Refresh()
+
+	if view := screen(model); !strings.Contains(view, "loading...") {
+		t.Errorf("view while loading:\n%s", view)
+	}
+	if model.HandleKey("down") {
+		t.Fatalf("key press while loading quit the UI")
+	}
+
+	settle(model)
+	if view := screen(model); !strings.Contains(view, "namespace: production") || !strings.Contains(view, "web") {
+		t.Errorf("namespace not opened after loading:\n%s", view)
+	}
+}
+
+func TestScaleContainer(t *testing.T) {
+	t.Parallel()
+
+	model, client := newTestModel(t)
+	press(model, "enter", "s", "3", "enter")
+
+	container, err := client.ListContainerByName(t.Context(), "production", "web")
+	if err != nil {
+		t.Fatalf("ListContainerByName() error = %v", err)
+	}
+	if container.NumberOfReplicas != 3 {
+		t.Errorf("container has %d replicas after scaling to 3, status %q", container.NumberOfReplicas, model.status)
+	}
+	if container.PrivateRegistry == nil || container.PrivateRegistry.Name != "gitlab" {
+		t.Errorf("scale did not keep the registry: %+v", container.PrivateRegistry)
+	}
+
+	press(model, "s", "x", "enter")
+	if !strings.Contains(model.status, "Invalid number of replicas") {
+		t.Errorf("invalid replicas were not rejected, status %q", model.status)
+	}
+}
+
+func TestParseKeys(t *testing.T) {
+	t.Parallel()
+
+	got := parseKeys([]byte("\x1b[Aj\r\x1b\x7fq"))
+	expected := []string{"up", "j", "enter", "esc", "backspace", "q"}
+	if !slices.Equal(got, expected) {
+		t.Errorf("parseKeys() = %q, expected %q", got, expected)
+	}
+}
@@ -0,0 +1,220 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/nexaa-cloud/nexaa-cli/api"
+)
+
+// The kinds of resources shown in the resource pane.
+const (
+	KindContainer       = "container"
+	KindJob             = "job"
+	KindVolume          = "volume"
+	KindDatabaseCluster = "database-cluster"
+	KindMessageQueue    = "message-queue"
+	KindRegistry        = "registry"
+)
+
+// resource is a row in the resource pane.
+type resource struct {
+	kind    string
+	name    string
+	state   string
+	locked  bool
+	details string
+	runs    []api.NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun
+}
+
+// resourceRows flattens the resources of a namespace in the order they are shown.
+func resourceRows(namespace api.NamespaceDescription) []resource {
+	var rows []resource
+
+	for _, container := range namespace.Containers {
+		rows = append(rows, resource{
+			kind:    KindContainer,
+			name:    container.Name,
+			state:   container.State,
+			locked:  container.Locked,
+			details: fmt.Sprintf("%d/%d replicas", container.AvailableReplicas, container.NumberOfReplicas),
+		})
+	}
+	for _, job := range namespace.ContainerJobs {
+		runs := append([]api.NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun{}, job.Runs...)
+		sort.SliceStable(runs, func(i, j int) bool {
+			if runs[i].StartTime == nil || runs[j].StartTime == nil {
+				return runs[j].StartTime == nil && runs[i].StartTime != nil
+			}
+			return runs[i].StartTime.After(*runs[j].StartTime)
+		})
+
+		details := job.Schedule
+		if len(runs) > 0 {
+			details += ", last run " + strings.ToLower(runs[0].Status)
+		}
+		rows = append(rows, resource{kind: KindJob, name: job.Name, state: job.State, locked: job.Locked, details: details, runs: runs})
+	}
+	for _, volume := range namespace.Volumes {
+		rows = append(rows, resource{
+			kind:    KindVolume,
+			name:    volume.Name,
+			state:   volume.State,
+			locked:  volume.Locked,
+			details: fmt.Sprintf("%.2f of %.0f GB", volume.Usage, volume.Size),
+		})
+	}
+	for _, cluster := range namespace.CloudDatabaseClusters {
+		rows = append(rows, resource{kind: KindDatabaseCluster, name: cluster.Name, state: cluster.State, locked: cluster.Locked})
+	}
+	for _, queue := range namespace.MessageQueues {
+		rows = append(rows, resource{kind: KindMessageQueue, name: queue.Name, state: queue.State, locked: queue.Locked})
+	}
+	for _, registry := range namespace.PrivateRegistries {
+		rows = append(rows, resource{kind: KindRegistry, name: registry.Name, state: registry.State, locked: registry.Locked})
+	}
+
+	return rows
+}
+
+// DependentsFunc returns the resources that still use the resource of the kind, such as KindVolume, with the
+// name in the namespace. The terminal UI refuses to delete a resource that has dependents.
+type DependentsFunc func(ctx context.Context, namespace string, kind string, name string) ([]string, error)
+
+func deleteResource(ctx context.Context, client Client, namespace string, row resource) error {
+	var err error
+	switch row.kind {
+	case KindContainer:
+		_, err = client.ContainerDelete(ctx, namespace, row.name)
+	case KindJob:
+		_, err = client.ContainerJobDelete(ctx, namespace, row.name)
+	case KindVolume:
+		_, err = client.VolumeDelete(ctx, namespace, row.name)
+	case KindDatabaseCluster:
+		_, err = client.CloudDatabaseClusterDelete(ctx, api.CloudDatabaseClusterResourceInput{Namespace: namespace, Name: row.name})
+	case KindMessageQueue:
+		_, err = client.MessageQueueDelete(ctx, api.MessageQueueResourceInput{Namespace: namespace, Name: row.name})
+	case KindRegistry:
+		_, err = client.RegistryDelete(ctx, namespace, row.name)
+	default:
+		err = fmt.Errorf("cannot delete a %s", row.kind)
+	}
+	return err
+}
+
+func scaleContainer(ctx context.Context, client Client, namespace string, name string, replicas int) error {
+	container, err := client.ListContainerByName(ctx, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	input := api.ContainerModifyInput{
+		Name:      container.Name,
+		Namespace: namespace,
+		Resources: &container.Resources,
+		Scaling:   &api.ScalingInput{Manual: &api.ManualScalingInput{Replicas: replicas}},
+	}
+	if container.PrivateRegistry != nil {
+		input.Registry = &container.PrivateRegistry.Name
+	}
+
+	_, err = client.ContainerModify(ctx, input)
+	return err
+}
+
+func describeContainer(container api.ContainerResult) []string {
+	lines := []string{
+		"Image:      " + container.Image,
+		"Resources:  " + string(container.Resources),
+		fmt.Sprintf("Replicas:   %d/%d available", container.AvailableReplicas, container.NumberOfReplicas),
+		"State:      " + container.State,
+	}
+	if container.PrivateRegistry != nil {
+		lines = append(lines, "Registry:   "+container.PrivateRegistry.Name)
+	}
+	if container.AutoScaling != nil {
+		lines = append(lines, fmt.Sprintf("Autoscale:  %d-%d replicas", container.AutoScaling.Replicas.Minimum, container.AutoScaling.Replicas.Maximum))
+	}
+	if len(container.Ports) > 0 {
+		lines = append(lines, "Ports:      "+strings.Join(container.Ports, ", "))
+	}
+	for _, ingress := range container.Ingresses {
+		lines = append(lines, fmt.Sprintf("Ingress:    %s -> %d (tls %t)", ingress.DomainName, ingress.Port, ingress.EnableTLS))
+	}
+	for _, mount := range container.Mounts {
+		lines = append(lines, fmt.Sprintf("Mount:      %s on %s", mount.Volume.Name, mount.Path))
+	}
+	return lines
+}
+
+func describeContainerJob(job api.ContainerJobResult) []string {
+	lines := []string{
+		"Image:      " + job.Image,
+		"Resources:  " + string(job.Resources),
+		"Schedule:   " + job.Schedule,
+		fmt.Sprintf("Enabled:    %t", job.Enabled),
+		"State:      " + job.State,
+	}
+	if job.PrivateRegistry != nil {
+		lines = append(lines, "Registry:   "+job.PrivateRegistry.Name)
+	}
+	if len(job.Command) > 0 {
+		lines = append(lines, "Command:    "+strings.Join(job.Command, " "))
+	}
+	for _, mount := range job.Mounts {
+		lines = append(lines, fmt.Sprintf("Mount:      %s on %s", mount.Volume.Name, mount.Path))
+	}
+	return lines
+}
+
+func environmentLines(envs []api.EnvironmentVariableResult) []string {
+	if len(envs) == 0 {
+		return []string{"No environment variables."}
+	}
+
+	var lines []string
+	for _, env := range envs {
+		value := "********"
+		if !env.Secret && env.Value != nil {
+			value = *env.Value
+		}
+		lines = append(lines, env.Name+"="+value)
+	}
+	sort.Strings(lines)
+	return lines
+}
+
+func connectionLines(container api.ContainerResult) []string {
+	if container.ExternalConnection == nil || len(container.ExternalConnection.Ports) == 0 {
+		return []string{"No external connections."}
+	}
+
+	connection := container.ExternalConnection
+	lines := []string{"IPv4: " + connection.Ipv4, "IPv6: " + connection.Ipv6, ""}
+	for _, port := range connection.Ports {
+		internal := "-"
+		if port.InternalPort != nil {
+			internal = fmt.Sprint(*port.InternalPort)
+		}
+		lines = append(lines, fmt.Sprintf("%d -> %s %s allow %s", port.ExternalPort, internal, port.Protocol, strings.Join(port.AllowList, ",")))
+	}
+	return lines
+}
+
+func runLines(runs []api.NamespaceDescriptionContainerJobsContainerJobRunsContainerJobRun) []string {
+	if len(runs) == 0 {
+		return []string{"No runs."}
+	}
+
+	var lines []string
+	for _, run := range runs {
+		started := "not started"
+		if run.StartTime != nil {
+			started = run.StartTime.Local().Format("2006-01-02 15:04:05")
+		}
+		lines = append(lines, fmt.Sprintf("%-20s %-12s %s", started, run.Status, run.Name))
+	}
+	return lines
+}
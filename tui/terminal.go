@@ -0,0 +1,135 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/term"
+)
+
+// keyNames maps the byte sequences sent by terminals to the key names used by Model.HandleKey.
+var keyNames = map[string]string{
+	"\x1b[A": "up",
+	"\x1bOA": "up",
+	"\x1b[B": "down",
+	"\x1bOB": "down",
+	"\x1b[C": "right",
+	"\x1bOC": "right",
+	"\x1b[D": "left",
+	"\x1bOD": "left",
+	"\x1b":   "esc",
+	"\r":     "enter",
+	"\n":     "enter",
+	"\t":     "tab",
+	"\x7f":   "backspace",
+	"\x08":   "backspace",
+	"\x03":   "ctrl+c",
+}
+
+// parseKeys splits the bytes of a single read into key names.
+func parseKeys(input []byte) []string {
+	var keys []string
+	for len(input) > 0 {
+		if input[0] == 0x1b && len(input) >= 3 {
+			if name, ok := keyNames[string(input[:3])]; ok {
+				keys = append(keys, name)
+				input = input[3:]
+				continue
+			}
+		}
+
+		if name, ok := keyNames[string(input[:1])]; ok {
+			keys = append(keys, name)
+		} else {
+			keys = append(keys, string(input[:1]))
+		}
+		input = input[1:]
+	}
+	return keys
+}
+
+func readKeys(reader io.Reader, keys chan<- string) {
+	buffer := make([]byte, 64)
+	for {
+		n, err := reader.Read(buffer)
+		if err != nil {
+			close(keys)
+			return
+		}
+		for _, key := range parseKeys(buffer[:n]) {
+			keys <- key
+		}
+	}
+}
+
+func draw(out io.Writer, lines []string) {
+	var frame strings.Builder
+	frame.WriteString("\033[H")
+	for i, line := range lines {
+		if i > 0 {
+			frame.WriteString("\r\n")
+		}
+		frame.WriteString(line + "\033[K")
+	}
+	frame.WriteString("\033[J")
+	fmt.Fprint(out, frame.String())
+}
+
+// Run starts the terminal UI on stdin and stdout and returns when the user quits.
+// The open namespace is reloaded every refresh interval, dependents is described at NewModel.
+func Run(ctx context.Context, client Client, dependents DependentsFunc, refresh time.Duration, namespace string) error {
+	stdin := int(os.Stdin.Fd())
+	stdout := int(os.Stdout.Fd())
+	if !term.IsTerminal(stdin) || !term.IsTerminal(stdout) {
+		return fmt.Errorf("the terminal UI needs an interactive terminal")
+	}
+
+	state, err := term.MakeRaw(stdin)
+	if err != nil {
+		return err
+	}
+	defer term.Restore(stdin, state)
+
+	// Switch to the alternate screen and hide the cursor, restore both on exit.
+	fmt.Print("\033[?1049h\033[?25l")
+	defer fmt.Print("\033[?25h\033[?1049l")
+
+	// Stop the requests still running in the background when the user quits.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	model := NewModel(ctx, client, dependents)
+	model.Refresh()
+	if namespace != "" {
+		model.SelectNamespace(namespace)
+	}
+
+	keys := make(chan string)
+	go readKeys(os.Stdin, keys)
+
+	ticker := time.NewTicker(refresh)
+	defer ticker.Stop()
+
+	for {
+		width, height, err := term.GetSize(stdout)
+		if err != nil {
+			width, height = 120, 40
+		}
+		draw(os.Stdout, model.View(width, height))
+
+		select {
+		case key, ok := <-keys:
+			if !ok || model.HandleKey(key) {
+				return nil
+			}
+		case result := <-model.updates:
+			model.apply(result)
+		case <-ticker.C:
+			model.Refresh()
+		}
+	}
+}